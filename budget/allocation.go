@@ -0,0 +1,96 @@
+package budget
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Buckets of the common allocation rules
+const (
+	NeedsBucket   = "needs"   // Essential expenses, such as housing, groceries and utilities
+	WantsBucket   = "wants"   // Discretionary expenses, such as dining out and entertainment
+	SavingsBucket = "savings" // Savings, investments and extra debt repayments
+)
+
+// AllocationRule describes how net income should be split between buckets of expenses, such as the 50/30/20 rule
+type AllocationRule struct {
+	Name   string
+	Shares map[string]quantity.Percentage // Share of net income for each bucket
+}
+
+// AllocationRules are the allocation rules known by name. Rules may be added from the configuration.
+var AllocationRules = map[string]*AllocationRule{
+	"50/30/20": {
+		Name:   "50/30/20",
+		Shares: map[string]quantity.Percentage{NeedsBucket: 0.5, WantsBucket: 0.3, SavingsBucket: 0.2},
+	},
+	"70/20/10": {
+		Name:   "70/20/10",
+		Shares: map[string]quantity.Percentage{NeedsBucket: 0.7, SavingsBucket: 0.2, WantsBucket: 0.1},
+	},
+}
+
+// NewAllocationRule makes a named allocation rule from the shares of each bucket, written as percentages, such as 50 or "50%".
+// The shares must add up to 100%.
+func NewAllocationRule(name string, shares map[string]interface{}) (*AllocationRule, error) {
+	rule := &AllocationRule{Name: name, Shares: make(map[string]quantity.Percentage, len(shares))}
+	var total float64
+	for bucket, shareValue := range shares {
+		share, err := quantity.NewPercentage(shareValue)
+		if err != nil || share.IsNaN() || share < 0 {
+			return nil, fmt.Errorf("allocation rule %s: invalid share %v for bucket %s", name, shareValue, bucket)
+		}
+		rule.Shares[NormalizeBucket(bucket)] = share
+		total += share.ValueOf()
+	}
+	if math.Abs(total-1) > 0.0001 {
+		return nil, fmt.Errorf("allocation rule %s: shares add up to %s, not 100%%", name, quantity.Percentage(total))
+	}
+	return rule, nil
+}
+
+// FindAllocationRule returns the allocation rule with the given name
+func FindAllocationRule(name string) (*AllocationRule, error) {
+	if rule, ok := AllocationRules[name]; ok {
+		return rule, nil
+	}
+	names := make([]string, 0, len(AllocationRules))
+	for ruleName := range AllocationRules {
+		names = append(names, ruleName)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown allocation rule %q; expected one of %s", name, strings.Join(names, ", "))
+}
+
+// Buckets returns the buckets of the rule, from largest to smallest share
+func (rule *AllocationRule) Buckets() []string {
+	buckets := make([]string, 0, len(rule.Shares))
+	for bucket := range rule.Shares {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if rule.Shares[buckets[i]] == rule.Shares[buckets[j]] {
+			return buckets[i] < buckets[j]
+		}
+		return rule.Shares[buckets[i]] > rule.Shares[buckets[j]]
+	})
+	return buckets
+}
+
+// NormalizeBucket writes the name of a bucket as it is stored, in lowercase without surrounding spaces
+func NormalizeBucket(bucket string) string {
+	return strings.ToLower(strings.TrimSpace(bucket))
+}
+
+// Buckets sums the expenses in each bucket in the given currency. Expenses without a bucket are summed under the empty bucket.
+func (list ExpenseList) Buckets(currency quantity.Currency) map[string]quantity.Money {
+	buckets := make(map[string]quantity.Money)
+	for name, expense := range list {
+		buckets[expense.Bucket] += list.Converted(name, currency)
+	}
+	return buckets
+}
@@ -10,9 +10,13 @@ import (
 
 // Budget describes a named budget comprised of income and expenses
 type Budget struct {
-	name     string
-	Income   IncomeList  `json:"income"`
-	Expenses ExpenseList `json:"expenses"`
+	name      string
+	Currency  quantity.Currency `json:"currency,omitempty"` // Currency the budget is reported in; if empty, quantity.DefaultCurrency
+	Income    IncomeList        `json:"income"`
+	Expenses  ExpenseList       `json:"expenses"`
+	Savings   quantity.Money    `json:"savings,omitempty"`   // Recorded savings balance, in the currency of the budget
+	Events    EventList         `json:"events,omitempty"`    // One-time events, such as bonuses and large purchases
+	Scenarios ScenarioList      `json:"scenarios,omitempty"` // What-if variations of the budget
 }
 
 // Make makes a named budget
@@ -26,12 +30,18 @@ func Make(name string) *Budget {
 
 // Load loads a budget from disk
 func Load(name string) (*Budget, error) {
+	return LoadFile(name, fmt.Sprintf("%s.budget", name))
+}
+
+// LoadFile loads a named budget from the given file, such as a copy of a budget saved elsewhere
+func LoadFile(name string, path string) (*Budget, error) {
 	budget := Make(name)
 
-	fileReader, err := os.Open(fmt.Sprintf("%s.budget", name))
+	fileReader, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
+	defer fileReader.Close()
 
 	decoder := json.NewDecoder(fileReader)
 	if err := decoder.Decode(&budget); err != nil {
@@ -43,7 +53,12 @@ func Load(name string) (*Budget, error) {
 
 // Save saves a budget to disk
 func (budget *Budget) Save() error {
-	fileWriter, err := os.Create(fmt.Sprintf("%s.budget", budget.name))
+	return budget.SaveFile(fmt.Sprintf("%s.budget", budget.name))
+}
+
+// SaveFile saves a budget to the given file, such as one in another directory
+func (budget *Budget) SaveFile(path string) error {
+	fileWriter, err := os.Create(path)
 	if err != nil {
 		return err
 	}
@@ -58,6 +73,21 @@ func (budget *Budget) Save() error {
 	return nil
 }
 
+// ReportingCurrency returns the currency the budget is reported in
+func (budget *Budget) ReportingCurrency() quantity.Currency {
+	return budget.Currency.Or(quantity.DefaultCurrency)
+}
+
+// Sum returns the amount remaining after expenses, in the currency of the budget
 func (budget *Budget) Sum() quantity.Money {
-	return budget.Income.Sum() - budget.Expenses.Sum()
+	currency := budget.ReportingCurrency()
+	return budget.Income.Sum(currency) - budget.Expenses.Sum(currency)
+}
+
+// PerPeriod rescales a monthly amount to the given period, such as $2,000 per month to $461.54 per week
+func PerPeriod(money quantity.Money, period quantity.Period) quantity.Money {
+	if period == quantity.Month {
+		return money
+	}
+	return quantity.Rate{Money: money, Per: quantity.Month}.In(period).Money
 }
@@ -0,0 +1,173 @@
+package budget
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// CashFlow describes an amount of money projected to be received or paid on a given date.
+// Positive amounts are money received, while negative amounts are money paid, in the currency of the budget.
+type CashFlow struct {
+	Date    time.Time      // Date the money is received or paid
+	Name    string         // Name of the income source or expense
+	Amount  quantity.Money // Signed amount received or paid
+	Balance quantity.Money // Projected balance after the money is received or paid
+	Assumed bool           // Whether the date was assumed, because no pay date or due day was recorded
+}
+
+// dayOfMonth returns the given day of a month, or the last day of the month if the month is shorter
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// monthsWithin calls visit with each month overlapping [start, end)
+func monthsWithin(start, end time.Time, visit func(year int, month time.Month)) {
+	for month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC); month.Before(end); month = month.AddDate(0, 1, 0) {
+		visit(month.Year(), month.Month())
+	}
+}
+
+// DueDates returns the dates within [start, end) the expense is due. Expenses without a due day are assumed to be due on the 1st.
+func (expense *Expense) DueDates(start, end time.Time) []time.Time {
+	due := expense.Due
+	if due < 1 {
+		due = 1
+	}
+	var dates []time.Time
+	monthsWithin(start, end, func(year int, month time.Month) {
+		if date := dayOfMonth(year, month, due); !date.Before(start) && date.Before(end) {
+			dates = append(dates, date)
+		}
+	})
+	return dates
+}
+
+// PayPeriod returns the period between paychecks of the income source. Income sources without a pay period are assumed to be paid
+// monthly, and those paid by the hour are assumed to be paid daily.
+func (attributes *IncomeAttributes) PayPeriod() quantity.Period {
+	switch {
+	case attributes.PaidEvery == nil:
+		return quantity.Month
+	case attributes.PaidEvery.Unit == quantity.HourUnit:
+		return quantity.Day
+	default:
+		return *attributes.PaidEvery
+	}
+}
+
+// SemimonthlyPaydays returns the days of the month an income source paid twice a month is paid, from its recorded pay date: the day
+// of the pay date, and the day 15 days before or after it. Pay dates on the 31st are paid on the 15th and the last day of the month.
+// In shorter months, days after the last day of the month are paid on the last day.
+func SemimonthlyPaydays(paidOn time.Time) (first, second int) {
+	day := paidOn.Day()
+	if day <= 15 {
+		return day, day + 15
+	}
+	if day == 31 {
+		return 15, 31
+	}
+	return day - 15, day
+}
+
+// Paydays returns the dates within [start, end) the income source is paid, counting from its recorded pay date every period
+// it is paid. Income sources paid twice a month are paid on the days of SemimonthlyPaydays. Income sources without a recorded
+// pay date are assumed to be first paid on the start date.
+func (attributes *IncomeAttributes) Paydays(start, end time.Time) []time.Time {
+	paidEvery := attributes.PayPeriod()
+	paidOn := start
+	if attributes.PaidOn != nil {
+		paidOn = attributes.PaidOn.Time
+	}
+
+	var dates []time.Time
+	switch paidEvery.Unit {
+	case quantity.DayUnit, quantity.WeekUnit:
+		step := int(paidEvery.Count)
+		if paidEvery.Unit == quantity.WeekUnit {
+			step *= 7
+		}
+		// Find the first payday on or after the start date, then step through the remaining paydays
+		offset := int(start.Sub(paidOn).Hours() / 24)
+		skipped := offset / step
+		if offset > 0 && offset%step != 0 {
+			skipped++
+		}
+		for date := paidOn.AddDate(0, 0, skipped*step); date.Before(end); date = date.AddDate(0, 0, step) {
+			dates = append(dates, date)
+		}
+	case quantity.SemimonthUnit:
+		first, second := SemimonthlyPaydays(paidOn)
+		monthsWithin(start, end, func(year int, month time.Month) {
+			for _, date := range []time.Time{dayOfMonth(year, month, first), dayOfMonth(year, month, second)} {
+				if !date.Before(start) && date.Before(end) {
+					dates = append(dates, date)
+				}
+			}
+		})
+	default:
+		months := int(paidEvery.Count)
+		switch paidEvery.Unit {
+		case quantity.QuarterUnit:
+			months *= 3
+		case quantity.YearUnit:
+			months *= 12
+		}
+		monthsWithin(start, end, func(year int, month time.Month) {
+			elapsed := (year-paidOn.Year())*12 + int(month-paidOn.Month())
+			if elapsed%months != 0 {
+				return
+			}
+			if date := dayOfMonth(year, month, paidOn.Day()); !date.Before(start) && date.Before(end) {
+				dates = append(dates, date)
+			}
+		})
+	}
+	return dates
+}
+
+// CashFlows projects the money received and paid by the budget within [start, end), day by day, from the given starting balance.
+// Each paycheck is the income per period it is paid, and each expense is paid in full once a month. On the same day, money is
+// received before it is paid. Amounts in other currencies are converted as of the day they are received or paid.
+func (budget *Budget) CashFlows(start, end time.Time, balance quantity.Money) []CashFlow {
+	currency := budget.ReportingCurrency()
+	var flows []CashFlow
+
+	for _, name := range budget.Income.SortedNames() {
+		income := budget.Income[name]
+		attributes := income.Attributes()
+		for _, date := range attributes.Paydays(start, end) {
+			if !attributes.Schedule.Includes(date) {
+				continue
+			}
+			amount := PerPeriod(Convert(income.MonthlyIncome(), attributes.Currency, currency, date), attributes.PayPeriod())
+			flows = append(flows, CashFlow{Date: date, Name: name, Amount: amount, Assumed: attributes.PaidOn == nil})
+		}
+	}
+	for _, name := range budget.Expenses.SortedNames() {
+		expense := budget.Expenses[name]
+		for _, date := range expense.DueDates(start, end) {
+			if !expense.Schedule.Includes(date) {
+				continue
+			}
+			amount := Convert(expense.MonthlyExpense(), expense.Currency, currency, date)
+			flows = append(flows, CashFlow{Date: date, Name: name, Amount: -amount, Assumed: expense.Due == 0})
+		}
+	}
+
+	sort.SliceStable(flows, func(i, j int) bool {
+		if flows[i].Date.Equal(flows[j].Date) {
+			return flows[i].Amount > 0 && flows[j].Amount <= 0
+		}
+		return flows[i].Date.Before(flows[j].Date)
+	})
+	for index := range flows {
+		balance += flows[index].Amount
+		flows[index].Balance = balance
+	}
+	return flows
+}
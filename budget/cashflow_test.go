@@ -0,0 +1,85 @@
+package budget
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// dates returns the given days of 2026 as dates, written as month and day
+func dates(days ...[2]int) []time.Time {
+	var dates []time.Time
+	for _, day := range days {
+		dates = append(dates, time.Date(2026, time.Month(day[0]), day[1], 0, 0, 0, 0, time.UTC))
+	}
+	return dates
+}
+
+func TestSemimonthlyPaydays(t *testing.T) {
+	start, end := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		paidOn int
+		want   []time.Time
+	}{
+		{1, dates([2]int{1, 1}, [2]int{1, 16}, [2]int{2, 1}, [2]int{2, 16})},
+		{15, dates([2]int{1, 15}, [2]int{1, 30}, [2]int{2, 15}, [2]int{2, 28})},
+		{20, dates([2]int{1, 5}, [2]int{1, 20}, [2]int{2, 5}, [2]int{2, 20})},
+		{30, dates([2]int{1, 15}, [2]int{1, 30}, [2]int{2, 15}, [2]int{2, 28})},
+		{31, dates([2]int{1, 15}, [2]int{1, 31}, [2]int{2, 15}, [2]int{2, 28})},
+	} {
+		paidEvery := quantity.Semimonth
+		attributes := IncomeAttributes{PaidEvery: &paidEvery, PaidOn: &Date{time.Date(2025, time.December, test.paidOn, 0, 0, 0, 0, time.UTC)}}
+		if got := attributes.Paydays(start, end); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("paid on the %d: got %v, want %v", test.paidOn, got, test.want)
+		}
+	}
+}
+
+func TestPaydays(t *testing.T) {
+	start, end := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		paidEvery quantity.Period
+		paidOn    time.Time
+		want      []time.Time
+	}{
+		{quantity.MakePeriod("biweekly"), time.Date(2025, time.December, 26, 0, 0, 0, 0, time.UTC), dates([2]int{1, 9}, [2]int{1, 23})},
+		{quantity.MakePeriod("weekly"), time.Date(2026, time.January, 30, 0, 0, 0, 0, time.UTC), dates([2]int{1, 2}, [2]int{1, 9}, [2]int{1, 16}, [2]int{1, 23}, [2]int{1, 30})},
+		{quantity.Month, time.Date(2025, time.October, 31, 0, 0, 0, 0, time.UTC), dates([2]int{1, 31})},
+		{quantity.MakePeriod("quarterly"), time.Date(2025, time.October, 10, 0, 0, 0, 0, time.UTC), dates([2]int{1, 10})},
+		{quantity.MakePeriod("quarterly"), time.Date(2025, time.November, 10, 0, 0, 0, 0, time.UTC), nil},
+	} {
+		paidEvery := test.paidEvery
+		attributes := IncomeAttributes{PaidEvery: &paidEvery, PaidOn: &Date{test.paidOn}}
+		if got := attributes.Paydays(start, end); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("paid every %s from %s: got %v, want %v", test.paidEvery, test.paidOn.Format(DateLayout), got, test.want)
+		}
+	}
+}
+
+func TestCashFlows(t *testing.T) {
+	flowing := Make("test")
+	paidEvery, paidOn := quantity.Semimonth, Date{time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC)}
+	flowing.Income["salary"] = &Supplemental{Money: 4000, IncomeAttributes: IncomeAttributes{PaidEvery: &paidEvery, PaidOn: &paidOn}}
+	flowing.Expenses["rent"] = &Expense{Amount: 1500, Due: 15}
+	flowing.Expenses["phone"] = &Expense{Amount: 50, Due: 20, Schedule: Schedule{End: &Date{time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)}}}
+
+	flows := flowing.CashFlows(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), 100)
+	var got []string
+	for _, flow := range flows {
+		got = append(got, flow.Date.Format(DateLayout)+" "+flow.Name+" "+flow.Amount.Format("USD")+" "+flow.Balance.Format("USD"))
+	}
+	want := []string{
+		"2026-01-15 salary $2,000.00 $2,100.00",
+		"2026-01-15 rent -$1,500.00 $600.00",
+		"2026-01-20 phone -$50.00 $550.00",
+		"2026-01-30 salary $2,000.00 $2,550.00",
+		"2026-02-15 salary $2,000.00 $4,550.00",
+		"2026-02-15 rent -$1,500.00 $3,050.00",
+		"2026-02-28 salary $2,000.00 $5,050.00",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
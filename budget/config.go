@@ -1,7 +1,12 @@
 package budget
 
+import "github.com/sorucoder/budgetbuddy/budget/quantity"
+
 var (
 	MinimumWage          float64
 	MinimumOvertimeHours float64
 	NetPayPercentage     float64
+	ExchangeRates        *quantity.ExchangeRates
+	HealthThresholds     = DefaultThresholds
+	TemplatesDir         string
 )
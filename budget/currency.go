@@ -0,0 +1,60 @@
+package budget
+
+import (
+	"math"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Convert converts money from one currency to another using ExchangeRates as of the given date. Money without a currency
+// is assumed to already be in the currency converted to. If no exchange rate is known, the result is NaN.
+func Convert(money quantity.Money, from, to quantity.Currency, date time.Time) quantity.Money {
+	converted, _ := ConvertAmount(quantity.Amount{Money: money, Currency: from}, to, date)
+	return converted.Money
+}
+
+// ConvertAmount converts an amount into another currency using ExchangeRates as of the given date. Amounts without a currency are
+// assumed to already be in the currency converted to. If no exchange rate is known, this returns an error along with an amount of NaN.
+func ConvertAmount(amount quantity.Amount, to quantity.Currency, date time.Time) (quantity.Amount, error) {
+	if amount.Currency == "" || amount.Currency == to {
+		return quantity.Amount{Money: amount.Money, Currency: to}, nil
+	}
+	converted, err := ExchangeRates.Convert(amount.Money, amount.Currency, to, date)
+	if err != nil {
+		return quantity.Amount{Money: quantity.Money(math.NaN()), Currency: to}, err
+	}
+	return quantity.Amount{Money: converted, Currency: to}, nil
+}
+
+// MissingExchangeRates returns an error for each currency of the budget that cannot be converted into the currency of the budget
+func (budget *Budget) MissingExchangeRates() []error {
+	return budget.MissingExchangeRatesInto(budget.ReportingCurrency())
+}
+
+// MissingExchangeRatesInto returns an error for each currency of the budget that cannot be converted into the given currency
+func (budget *Budget) MissingExchangeRatesInto(currency quantity.Currency) []error {
+	checked := make(map[quantity.Currency]bool)
+	errs := make([]error, 0)
+
+	check := func(from quantity.Currency) {
+		if from == "" || from == currency || checked[from] {
+			return
+		}
+		checked[from] = true
+		if _, err := ExchangeRates.Rate(from, currency, time.Now()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, name := range budget.Income.SortedNames() {
+		check(budget.Income[name].Attributes().Currency)
+	}
+	for _, name := range budget.Expenses.SortedNames() {
+		check(budget.Expenses[name].Currency)
+	}
+	for _, name := range budget.Events.SortedNames() {
+		check(budget.Events[name].Currency)
+	}
+
+	return errs
+}
@@ -0,0 +1,278 @@
+package budget
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Kinds of items compared between budgets
+const (
+	IncomeItem   = "income"
+	ExpenseItem  = "expense"
+	EventItem    = "event"
+	ScenarioItem = "scenario"
+)
+
+// Changes made to items between budgets
+const (
+	Added    = "added"
+	Removed  = "removed"
+	Modified = "modified"
+)
+
+// FieldChange describes a field that differs between budgets, such as the hours of wages. Fields of nested values are named
+// by their path, such as "varies.max". Values are as written in budget files, and missing if the field is empty.
+type FieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// ItemDiff describes an income source, expense, one-time event or scenario added, removed or modified between budgets
+type ItemDiff struct {
+	Kind   string         `json:"kind"`             // Kind of item, such as income or expense
+	Name   string         `json:"name"`             // Name of the item
+	Change string         `json:"change"`           // Whether the item was added, removed or modified
+	Fields []FieldChange  `json:"fields,omitempty"` // Fields that differ, if modified
+	Before quantity.Money `json:"before"`           // Monthly amount of an income source or expense, or amount of an event, before
+	After  quantity.Money `json:"after"`            // Monthly amount of an income source or expense, or amount of an event, after
+}
+
+// TotalDiff describes a monthly total that differs between budgets
+type TotalDiff struct {
+	Before     quantity.Money `json:"before"`
+	After      quantity.Money `json:"after"`
+	Difference quantity.Money `json:"difference"`
+}
+
+// MarshalJSON implements json.Marshaler for ItemDiff. Amounts that could not be converted are written as null, since JSON has no NaN.
+func (item ItemDiff) MarshalJSON() ([]byte, error) {
+	type itemDiffJSON ItemDiff
+	return json.Marshal(struct {
+		itemDiffJSON
+		Before *float64 `json:"before"`
+		After  *float64 `json:"after"`
+	}{itemDiffJSON(item), finiteMoney(item.Before), finiteMoney(item.After)})
+}
+
+// MarshalJSON implements json.Marshaler for TotalDiff. Amounts that could not be converted are written as null, since JSON has no NaN.
+func (total TotalDiff) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Before     *float64 `json:"before"`
+		After      *float64 `json:"after"`
+		Difference *float64 `json:"difference"`
+	}{finiteMoney(total.Before), finiteMoney(total.After), finiteMoney(total.Difference)})
+}
+
+// finiteMoney returns the value of money, or nil if it is NaN or infinite
+func finiteMoney(money quantity.Money) *float64 {
+	if money.IsNaN() || money.IsInf(0) {
+		return nil
+	}
+	value := money.ValueOf()
+	return &value
+}
+
+// sameMoney reports whether two amounts are the same, counting amounts that could not be converted as the same
+func sameMoney(a, b quantity.Money) bool {
+	return a == b || (a.IsNaN() && b.IsNaN())
+}
+
+// makeTotalDiff makes the difference of a total between budgets
+func makeTotalDiff(before, after quantity.Money) TotalDiff {
+	return TotalDiff{Before: before, After: after, Difference: after - before}
+}
+
+// Diff describes the differences between two budgets, with amounts per month in the currency of the later budget
+type Diff struct {
+	Currency quantity.Currency `json:"currency"`
+	Fields   []FieldChange     `json:"fields,omitempty"` // Fields of the budgets themselves that differ, such as the currency
+	Items    []ItemDiff        `json:"items"`
+	Income   TotalDiff         `json:"income"`
+	Expenses TotalDiff         `json:"expenses"`
+	Sum      TotalDiff         `json:"sum"`                // Amount remaining after expenses
+	Warnings []string          `json:"warnings,omitempty"` // Currencies of either budget that could not be converted, whose amounts are unknown
+}
+
+// flattenJSON decodes a value written in JSON into a map of its fields, naming the fields of nested objects by their path
+func flattenJSON(prefix string, value interface{}, fields map[string]interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if prefix != "" {
+			fields[prefix] = value
+		}
+		return
+	}
+	for name, field := range object {
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		flattenJSON(name, field, fields)
+	}
+}
+
+// fieldsOf returns the fields of a value as written in budget files
+func fieldsOf(value interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if value == nil {
+		return fields
+	}
+	if pointer := reflect.ValueOf(value); pointer.Kind() == reflect.Ptr && pointer.IsNil() {
+		return fields
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fields
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fields
+	}
+	flattenJSON("", decoded, fields)
+	return fields
+}
+
+// compareFields returns the fields that differ between two sets of fields, sorted by name
+func compareFields(before, after map[string]interface{}) []FieldChange {
+	names := make(map[string]bool)
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	var changes []FieldChange
+	for name := range names {
+		if !reflect.DeepEqual(before[name], after[name]) {
+			changes = append(changes, FieldChange{Field: name, Before: before[name], After: after[name]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// incomeFields returns the fields of an income source, with its type
+func incomeFields(income Income) map[string]interface{} {
+	fields := fieldsOf(income)
+	if income != nil {
+		fields["type"] = reflect.Indirect(reflect.ValueOf(income)).Type().Name()
+	}
+	return fields
+}
+
+// expenseFields returns the fields of an expense, even if it is written as a plain number
+func expenseFields(expense *Expense) map[string]interface{} {
+	type expenseJSON Expense
+	return fieldsOf((*expenseJSON)(expense))
+}
+
+// compareItem adds the difference of an item between budgets, if any
+func (diff *Diff) compareItem(kind string, name string, exists [2]bool, fields [2]map[string]interface{}, amounts [2]quantity.Money) {
+	item := ItemDiff{Kind: kind, Name: name, Before: amounts[0], After: amounts[1]}
+	switch {
+	case !exists[0]:
+		item.Change = Added
+	case !exists[1]:
+		item.Change = Removed
+	default:
+		item.Fields = compareFields(fields[0], fields[1])
+		if len(item.Fields) == 0 && sameMoney(amounts[0], amounts[1]) {
+			return
+		}
+		item.Change = Modified
+	}
+	diff.Items = append(diff.Items, item)
+}
+
+// sortedUnion returns the names of both lists of names, sorted lexographically
+func sortedUnion(before, after []string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, name := range append(before, after...) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Compare returns the differences between a budget before and after it was changed: the income sources, expenses, one-time
+// events and scenarios added, removed or modified, and the resulting change in monthly income, expenses and amount remaining.
+// Amounts of both budgets are converted into the currency of the later budget.
+func Compare(before, after *Budget) Diff {
+	currency := after.ReportingCurrency()
+	diff := Diff{
+		Currency: currency,
+		Items:    make([]ItemDiff, 0),
+		Fields: compareFields(
+			map[string]interface{}{"currency": string(before.ReportingCurrency()), "savings": before.Savings.ValueOf()},
+			map[string]interface{}{"currency": string(after.ReportingCurrency()), "savings": after.Savings.ValueOf()},
+		),
+	}
+
+	for _, name := range sortedUnion(before.Income.SortedNames(), after.Income.SortedNames()) {
+		beforeIncome, inBefore := before.Income[name]
+		afterIncome, inAfter := after.Income[name]
+		var amounts [2]quantity.Money
+		if inBefore {
+			amounts[0] = Convert(beforeIncome.MonthlyIncome(), beforeIncome.Attributes().Currency, currency, time.Now())
+		}
+		if inAfter {
+			amounts[1] = after.Income.Converted(name, currency)
+		}
+		diff.compareItem(IncomeItem, name, [2]bool{inBefore, inAfter}, [2]map[string]interface{}{incomeFields(beforeIncome), incomeFields(afterIncome)}, amounts)
+	}
+
+	for _, name := range sortedUnion(before.Expenses.SortedNames(), after.Expenses.SortedNames()) {
+		beforeExpense, inBefore := before.Expenses[name]
+		afterExpense, inAfter := after.Expenses[name]
+		var amounts [2]quantity.Money
+		if inBefore {
+			amounts[0] = Convert(beforeExpense.MonthlyExpense(), beforeExpense.Currency, currency, time.Now())
+		}
+		if inAfter {
+			amounts[1] = after.Expenses.Converted(name, currency)
+		}
+		diff.compareItem(ExpenseItem, name, [2]bool{inBefore, inAfter}, [2]map[string]interface{}{expenseFields(beforeExpense), expenseFields(afterExpense)}, amounts)
+	}
+
+	for _, name := range sortedUnion(before.Events.SortedNames(), after.Events.SortedNames()) {
+		beforeEvent, inBefore := before.Events[name]
+		afterEvent, inAfter := after.Events[name]
+		var amounts [2]quantity.Money
+		if inBefore {
+			amounts[0] = Convert(beforeEvent.Amount, beforeEvent.Currency, currency, time.Now())
+		}
+		if inAfter {
+			amounts[1] = Convert(afterEvent.Amount, afterEvent.Currency, currency, time.Now())
+		}
+		diff.compareItem(EventItem, name, [2]bool{inBefore, inAfter}, [2]map[string]interface{}{fieldsOf(beforeEvent), fieldsOf(afterEvent)}, amounts)
+	}
+
+	for _, name := range sortedUnion(before.Scenarios.SortedNames(), after.Scenarios.SortedNames()) {
+		beforeScenario, inBefore := before.Scenarios[name]
+		afterScenario, inAfter := after.Scenarios[name]
+		diff.compareItem(ScenarioItem, name, [2]bool{inBefore, inAfter}, [2]map[string]interface{}{fieldsOf(beforeScenario), fieldsOf(afterScenario)}, [2]quantity.Money{})
+	}
+
+	diff.Income = makeTotalDiff(before.Income.Sum(currency), after.Income.Sum(currency))
+	diff.Expenses = makeTotalDiff(before.Expenses.Sum(currency), after.Expenses.Sum(currency))
+	diff.Sum = makeTotalDiff(diff.Income.Before-diff.Expenses.Before, diff.Income.After-diff.Expenses.After)
+
+	warned := make(map[string]bool)
+	for _, err := range append(before.MissingExchangeRatesInto(currency), after.MissingExchangeRatesInto(currency)...) {
+		if !warned[err.Error()] {
+			warned[err.Error()] = true
+			diff.Warnings = append(diff.Warnings, err.Error())
+		}
+	}
+	return diff
+}
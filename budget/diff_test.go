@@ -0,0 +1,46 @@
+package budget
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestCompareWithoutExchangeRates(t *testing.T) {
+	defer func(rates *quantity.ExchangeRates) { ExchangeRates = rates }(ExchangeRates)
+	ExchangeRates = quantity.NewExchangeRates()
+
+	before, after := Make("before"), Make("after")
+	before.Income["job"] = &Supplemental{Money: 3000}
+	after.Income["job"] = &Supplemental{Money: 3200}
+	for _, compared := range []*Budget{before, after} {
+		compared.Expenses["trip"] = &Expense{Amount: 300, Currency: "EUR"}
+	}
+
+	diff := Compare(before, after)
+	if len(diff.Items) != 1 || diff.Items[0].Name != "job" {
+		t.Errorf("got changed items %+v, want only job", diff.Items)
+	}
+	if len(diff.Warnings) != 1 {
+		t.Errorf("got warnings %v, want one about EUR", diff.Warnings)
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("could not write the differences as JSON: %s", err)
+	}
+	var decoded struct {
+		Income   map[string]*float64 `json:"income"`
+		Expenses map[string]*float64 `json:"expenses"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if difference := decoded.Income["difference"]; difference == nil || *difference != 200 {
+		t.Errorf("got income difference %v, want 200", difference)
+	}
+	if decoded.Expenses["before"] != nil || decoded.Expenses["after"] != nil {
+		t.Errorf("got expenses %v, want null amounts that could not be converted", decoded.Expenses)
+	}
+}
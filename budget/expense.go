@@ -1,23 +1,76 @@
 package budget
 
 import (
+	"encoding/json"
 	"sort"
+	"time"
 
 	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
+// Expense describes a monthly expense
+type Expense struct {
+	Amount   quantity.Money    `json:"amount"`             // Amount paid per month
+	Currency quantity.Currency `json:"currency,omitempty"` // Currency paid in; if empty, the currency of the budget
+	Bucket   string            `json:"bucket,omitempty"`   // Bucket of allocation rules, such as needs, wants or savings; if empty, untagged
+	Kind     string            `json:"kind,omitempty"`     // Kind of expense singled out by health metrics, either housing or debt; if empty, neither
+	Fixed    bool              `json:"fixed,omitempty"`    // Whether the amount is fixed, rather than discretionary
+	Due      int               `json:"due,omitempty"`      // Day of the month the expense is due, from 1 to 31; if empty, unknown
+	Schedule
+	Inflation *quantity.Percentage `json:"inflation,omitempty"` // Yearly rate of inflation of the amount; if empty, the assumed rate of inflation
+	Varies    *Distribution        `json:"varies,omitempty"`    // How the amount paid per month varies, for simulations; if empty, it is fixed
+}
+
+// MonthlyExpense returns the amount paid per month, in the currency of the expense
+func (expense *Expense) MonthlyExpense() quantity.Money {
+	return expense.Amount
+}
+
+// MarshalJSON implements json.Marshaler for Expense. Expenses with only an amount are written as a plain number.
+func (expense *Expense) MarshalJSON() ([]byte, error) {
+	type expenseJSON Expense
+	if expense.Currency == "" && expense.Bucket == "" && expense.Kind == "" && !expense.Fixed && expense.Due == 0 &&
+		expense.Start == nil && expense.End == nil && expense.Inflation == nil && expense.Varies == nil {
+		return json.Marshal(expense.Amount.ValueOf())
+	}
+	return json.Marshal((*expenseJSON)(expense))
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Expense. Expenses may be written as a plain number.
+func (expense *Expense) UnmarshalJSON(data []byte) error {
+	type expenseJSON Expense
+	var amount float64
+	if err := json.Unmarshal(data, &amount); err == nil {
+		*expense = Expense{Amount: quantity.Money(amount)}
+		return nil
+	}
+	return json.Unmarshal(data, (*expenseJSON)(expense))
+}
+
 // ExpenseList is a named list of monthly expenses
-type ExpenseList map[string]quantity.Money
+type ExpenseList map[string]*Expense
 
-// Sum adds all expenses together
-func (list ExpenseList) Sum() quantity.Money {
+// Sum adds all expenses together in the given currency. Expenses without a currency are assumed to be in the given currency.
+func (list ExpenseList) Sum(currency quantity.Currency) quantity.Money {
 	var total quantity.Money
-	for _, expense := range list {
-		total += expense
+	for name := range list {
+		total += list.Converted(name, currency)
 	}
 	return total
 }
 
+// Amount returns the monthly amount of the named expense in its own currency, which is the given currency of the budget if unspecified
+func (list ExpenseList) Amount(name string, currency quantity.Currency) quantity.Amount {
+	expense := list[name]
+	return quantity.Amount{Money: expense.MonthlyExpense(), Currency: expense.Currency.Or(currency)}
+}
+
+// Converted returns the monthly amount of the named expense in the given currency, as of today
+func (list ExpenseList) Converted(name string, currency quantity.Currency) quantity.Money {
+	expense := list[name]
+	return Convert(expense.MonthlyExpense(), expense.Currency, currency, time.Now())
+}
+
 // SortedNames sorts the names of expenses lexographically
 func (list ExpenseList) SortedNames() []string {
 	names := make([]string, 0, len(list))
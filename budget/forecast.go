@@ -0,0 +1,161 @@
+package budget
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Event describes an amount of money received or spent once, such as a bonus or the purchase of a car
+type Event struct {
+	Date     Date              `json:"date"`               // Date the money is received or spent
+	Amount   quantity.Money    `json:"amount"`             // Signed amount; positive amounts are received, and negative amounts are spent
+	Currency quantity.Currency `json:"currency,omitempty"` // Currency of the amount; if empty, the currency of the budget
+}
+
+// EventList is a named list of one-time events
+type EventList map[string]*Event
+
+// SortedNames sorts the names of events chronologically, then lexographically
+func (list EventList) SortedNames() []string {
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if list[names[i]].Date.Equal(list[names[j]].Date.Time) {
+			return names[i] < names[j]
+		}
+		return list[names[i]].Date.Before(list[names[j]].Date.Time)
+	})
+	return names
+}
+
+// Schedule describes when an income source or expense applies, by month
+type Schedule struct {
+	Start *Date `json:"start,omitempty"` // Date the income source or expense starts; if empty, it has already started
+	End   *Date `json:"end,omitempty"`   // Date the income source or expense ends; if empty, it never ends
+}
+
+// Includes reports whether the schedule applies on the given date
+func (schedule Schedule) Includes(date time.Time) bool {
+	return (schedule.Start == nil || !schedule.Start.After(date)) && (schedule.End == nil || !schedule.End.Before(date))
+}
+
+// Applies reports whether the schedule applies during any of the month starting at the given date
+func (schedule Schedule) Applies(month time.Time) bool {
+	if schedule.Start != nil && !schedule.Start.Before(month.AddDate(0, 1, 0)) {
+		return false
+	}
+	if schedule.End != nil && schedule.End.Before(month) {
+		return false
+	}
+	return true
+}
+
+// ForecastMonth describes the projected income and expenses of a budget for a month, in the currency of the budget
+type ForecastMonth struct {
+	Month    time.Time      // First day of the month
+	Income   quantity.Money // Income received, after raises
+	Expenses quantity.Money // Expenses paid, after inflation
+	Events   quantity.Money // Signed sum of the one-time events of the month
+	Savings  quantity.Money // Cumulative income, less expenses, with one-time events, since the start of the forecast
+	NetWorth quantity.Money // Starting balance with cumulative savings
+}
+
+// Net returns the income less expenses, with one-time events, of the month
+func (month ForecastMonth) Net() quantity.Money {
+	return month.Income - month.Expenses + month.Events
+}
+
+// monthsBetween returns the number of whole months from one month to another
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+}
+
+// raises returns the number of raises an income source has received by the given month since the first month of a forecast. Raises are
+// received every raise period from the month of its recorded raise date, or else its start date, or else the first month of the forecast,
+// so that they fall in the same months wherever the forecast starts.
+func (attributes *IncomeAttributes) raises(start, month time.Time) int {
+	if attributes.Raise == 0 {
+		return 0
+	}
+	raiseEvery := quantity.Year
+	if attributes.RaiseEvery != nil {
+		raiseEvery = *attributes.RaiseEvery
+	}
+	if !raiseEvery.IsCalendar() {
+		return 0
+	}
+	months, _ := quantity.Month.In(raiseEvery).Float64()
+	if months <= 0 {
+		return 0
+	}
+
+	from := start
+	if attributes.RaisedOn != nil {
+		from = attributes.RaisedOn.Time
+	} else if attributes.Start != nil {
+		from = attributes.Start.Time
+	}
+	received := func(by time.Time) int {
+		if elapsed := monthsBetween(from, by); elapsed > 0 {
+			return int(math.Floor(float64(elapsed)/months + 1e-9))
+		}
+		return 0
+	}
+	return received(month) - received(start)
+}
+
+// Forecast projects the income and expenses of the budget month by month from the month of the given start date, applying the raises
+// and start and end dates of income sources, the inflation rates and start and end dates of expenses, and one-time events. Expenses without
+// an inflation rate inflate at the given yearly rate. The amounts of the budget are those of the first month, which later raises and
+// inflation grow from. Cumulative savings and net worth are counted from the given starting balance. Amounts in other currencies are converted as of the first day of each month, and one-time events as of their dates.
+func (budget *Budget) Forecast(start time.Time, months int, inflation quantity.Percentage, balance quantity.Money) []ForecastMonth {
+	currency := budget.ReportingCurrency()
+	start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	forecast := make([]ForecastMonth, 0, months)
+	var savings quantity.Money
+
+	for index := 0; index < months; index++ {
+		month := ForecastMonth{Month: start.AddDate(0, index, 0)}
+
+		for _, name := range budget.Income.SortedNames() {
+			income := budget.Income[name]
+			attributes := income.Attributes()
+			if !attributes.Schedule.Applies(month.Month) {
+				continue
+			}
+			growth := math.Pow(1+attributes.Raise.ValueOf(), float64(attributes.raises(start, month.Month)))
+			month.Income += quantity.Money(Convert(income.MonthlyIncome(), attributes.Currency, currency, month.Month).ValueOf() * growth)
+		}
+
+		for _, name := range budget.Expenses.SortedNames() {
+			expense := budget.Expenses[name]
+			if !expense.Schedule.Applies(month.Month) {
+				continue
+			}
+			rate := inflation
+			if expense.Inflation != nil {
+				rate = *expense.Inflation
+			}
+			growth := math.Pow(1+rate.ValueOf(), float64(index)/12)
+			month.Expenses += quantity.Money(Convert(expense.MonthlyExpense(), expense.Currency, currency, month.Month).ValueOf() * growth)
+		}
+
+		for _, name := range budget.Events.SortedNames() {
+			event := budget.Events[name]
+			if monthsBetween(month.Month, event.Date.Time) == 0 {
+				month.Events += Convert(event.Amount, event.Currency, currency, event.Date.Time)
+			}
+		}
+
+		savings += month.Net()
+		month.Savings = savings
+		month.NetWorth = balance + savings
+		forecast = append(forecast, month)
+	}
+	return forecast
+}
@@ -0,0 +1,109 @@
+package budget
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// firstOf returns the first day of the given month
+func firstOf(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// near reports whether two amounts of money are equal to within a cent
+func near(got, want quantity.Money) bool {
+	return math.Abs(got.ValueOf()-want.ValueOf()) < 0.005
+}
+
+func TestForecastRaises(t *testing.T) {
+	forecasted := Make("test")
+	raiseEvery := quantity.Period{Count: 6, Unit: quantity.MonthUnit}
+	forecasted.Income["salary"] = &Supplemental{Money: 1000, IncomeAttributes: IncomeAttributes{
+		Raise:      0.1,
+		RaiseEvery: &raiseEvery,
+		RaisedOn:   &Date{time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)},
+	}}
+
+	// Raises fall in March and September, wherever the forecast starts
+	forecast := forecasted.Forecast(firstOf(2026, time.January), 12, 0, 0)
+	for index, want := range []quantity.Money{1000, 1000, 1100, 1100, 1100, 1100, 1100, 1100, 1210, 1210, 1210, 1210} {
+		if got := forecast[index].Income; !near(got, want) {
+			t.Errorf("%s: got %v, want %v", forecast[index].Month.Format(MonthLayout), got, want)
+		}
+	}
+	forecast = forecasted.Forecast(firstOf(2026, time.May), 6, 0, 0)
+	for index, want := range []quantity.Money{1000, 1000, 1000, 1000, 1100, 1100} {
+		if got := forecast[index].Income; !near(got, want) {
+			t.Errorf("from 2026-05, %s: got %v, want %v", forecast[index].Month.Format(MonthLayout), got, want)
+		}
+	}
+
+	// Without a raise date, raises are counted from the start date, or else the first month of the forecast
+	start := Date{firstOf(2026, time.April)}
+	forecasted.Income["salary"].Attributes().RaisedOn = nil
+	forecasted.Income["salary"].Attributes().Start = &start
+	forecast = forecasted.Forecast(firstOf(2026, time.January), 12, 0, 0)
+	for index, want := range []quantity.Money{0, 0, 0, 1000, 1000, 1000, 1000, 1000, 1000, 1100, 1100, 1100} {
+		if got := forecast[index].Income; !near(got, want) {
+			t.Errorf("from the start date, %s: got %v, want %v", forecast[index].Month.Format(MonthLayout), got, want)
+		}
+	}
+	forecasted.Income["salary"].Attributes().Start = nil
+	forecast = forecasted.Forecast(firstOf(2026, time.January), 8, 0, 0)
+	if got := forecast[6].Income; !near(got, 1100) {
+		t.Errorf("from the first month, 2026-07: got %v, want 1100", got)
+	}
+}
+
+func TestForecastInflation(t *testing.T) {
+	forecasted := Make("test")
+	inflation := quantity.Percentage(0.2)
+	forecasted.Expenses["rent"] = &Expense{Amount: 1000}
+	forecasted.Expenses["tuition"] = &Expense{Amount: 500, Inflation: &inflation}
+
+	forecast := forecasted.Forecast(firstOf(2026, time.January), 25, 0.1, 0)
+	for _, test := range []struct {
+		index int
+		want  quantity.Money
+	}{
+		{0, 1500},
+		{6, quantity.Money(1000*math.Pow(1.1, 0.5) + 500*math.Pow(1.2, 0.5))},
+		{12, 1000*1.1 + 500*1.2},
+		{24, 1000*1.1*1.1 + 500*1.2*1.2},
+	} {
+		if got := forecast[test.index].Expenses; !near(got, test.want) {
+			t.Errorf("%s: got %v, want %v", forecast[test.index].Month.Format(MonthLayout), got, test.want)
+		}
+	}
+}
+
+func TestForecastSchedules(t *testing.T) {
+	forecasted := Make("test")
+	forecasted.Income["salary"] = &Supplemental{Money: 3000}
+	forecasted.Income["contract"] = &Supplemental{Money: 500, IncomeAttributes: IncomeAttributes{Schedule: Schedule{
+		Start: &Date{time.Date(2026, time.February, 20, 0, 0, 0, 0, time.UTC)},
+		End:   &Date{time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)},
+	}}}
+	forecasted.Expenses["rent"] = &Expense{Amount: 1000}
+	forecasted.Expenses["loan"] = &Expense{Amount: 200, Schedule: Schedule{End: &Date{time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)}}}
+	forecasted.Events = EventList{"bonus": {Date: Date{time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)}, Amount: 250}}
+
+	forecast := forecasted.Forecast(time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC), 4, 0, 100)
+	for index, want := range []struct {
+		income, expenses, events, netWorth quantity.Money
+	}{
+		{3000, 1200, 0, 1900},
+		{3500, 1200, 0, 4200},
+		{3500, 1000, 250, 6950},
+		{3000, 1000, 0, 8950},
+	} {
+		got := forecast[index]
+		if !near(got.Income, want.income) || !near(got.Expenses, want.expenses) || !near(got.Events, want.events) || !near(got.NetWorth, want.netWorth) {
+			t.Errorf("%s: got income %v, expenses %v, events %v and net worth %v, want %v, %v, %v and %v", got.Month.Format(MonthLayout),
+				got.Income, got.Expenses, got.Events, got.NetWorth, want.income, want.expenses, want.events, want.netWorth)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package budget
+
+import (
+	"math"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Kinds of expenses that financial health metrics single out
+const (
+	HousingKind = "housing" // Rent, mortgage payments and other costs of housing
+	DebtKind    = "debt"    // Minimum payments on loans and credit cards
+)
+
+// Thresholds describes the values of financial health metrics past which a budget is warned about
+type Thresholds struct {
+	SavingsRate         quantity.Percentage // Minimum share of net income saved
+	ExpenseRatio        quantity.Percentage // Maximum share of net income spent
+	DebtRatio           quantity.Percentage // Maximum share of net income paid towards debts
+	HousingRatio        quantity.Percentage // Maximum share of net income paid towards housing
+	EmergencyFundMonths quantity.Number     // Minimum months of spending covered by savings
+	FixedExpenseRatio   quantity.Percentage // Maximum share of expenses that are fixed
+}
+
+// DefaultThresholds are the thresholds of financial health metrics commonly recommended
+var DefaultThresholds = Thresholds{
+	SavingsRate:         0.2,
+	ExpenseRatio:        0.8,
+	DebtRatio:           0.36,
+	HousingRatio:        0.3,
+	EmergencyFundMonths: 3,
+	FixedExpenseRatio:   0.6,
+}
+
+// Health describes the amounts of a budget that financial health metrics are calculated from, per month in the currency of the budget
+type Health struct {
+	Income        quantity.Money // Net income
+	Spending      quantity.Money // Expenses, excluding those in the savings bucket
+	Saved         quantity.Money // Expenses in the savings bucket, and income left unallocated
+	Debt          quantity.Money // Expenses of the debt kind
+	Housing       quantity.Money // Expenses of the housing kind
+	Fixed         quantity.Money // Expenses that are fixed
+	Discretionary quantity.Money // Expenses that are not fixed
+	Balance       quantity.Money // Recorded savings balance
+}
+
+// Health gathers the amounts financial health metrics of the budget are calculated from
+func (budget *Budget) Health() Health {
+	currency := budget.ReportingCurrency()
+	health := Health{
+		Income:  budget.Income.Sum(currency),
+		Balance: budget.Savings,
+	}
+	for name, expense := range budget.Expenses {
+		amount := budget.Expenses.Converted(name, currency)
+		if expense.Bucket == SavingsBucket {
+			health.Saved += amount
+		} else {
+			health.Spending += amount
+		}
+		switch expense.Kind {
+		case DebtKind:
+			health.Debt += amount
+		case HousingKind:
+			health.Housing += amount
+		}
+		if expense.Fixed {
+			health.Fixed += amount
+		} else {
+			health.Discretionary += amount
+		}
+	}
+	if remaining := budget.Sum(); remaining > 0 {
+		health.Saved += remaining
+	}
+	return health
+}
+
+// ratio returns an amount as a share of another amount
+func ratio(amount, of quantity.Money) quantity.Percentage {
+	if of == 0 {
+		return quantity.Percentage(math.NaN())
+	}
+	return quantity.Percentage(amount.ValueOf() / of.ValueOf())
+}
+
+// SavingsRate returns the share of net income saved
+func (health Health) SavingsRate() quantity.Percentage {
+	return ratio(health.Saved, health.Income)
+}
+
+// ExpenseRatio returns the share of net income spent
+func (health Health) ExpenseRatio() quantity.Percentage {
+	return ratio(health.Spending, health.Income)
+}
+
+// DebtRatio returns the share of net income paid towards debts
+func (health Health) DebtRatio() quantity.Percentage {
+	return ratio(health.Debt, health.Income)
+}
+
+// HousingRatio returns the share of net income paid towards housing
+func (health Health) HousingRatio() quantity.Percentage {
+	return ratio(health.Housing, health.Income)
+}
+
+// EmergencyFundMonths returns the number of months of spending the recorded savings balance covers
+func (health Health) EmergencyFundMonths() quantity.Number {
+	if health.Spending == 0 {
+		return quantity.Number(math.Inf(1))
+	}
+	return quantity.Number(health.Balance.ValueOf() / health.Spending.ValueOf())
+}
+
+// FixedExpenseRatio returns the share of expenses that are fixed
+func (health Health) FixedExpenseRatio() quantity.Percentage {
+	return ratio(health.Fixed, health.Fixed+health.Discretionary)
+}
@@ -0,0 +1,210 @@
+package budget
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Methods of splitting the shared expenses of a household between its members
+const (
+	IncomeSplit = "income" // In proportion to the income of each member
+	EqualSplit  = "equal"  // Equally between members
+	RatioSplit  = "ratio"  // By a fixed share for each member
+)
+
+// Split describes how the shared expenses of a household are split between its members
+type Split struct {
+	Method string                         `json:"method,omitempty"` // Method of splitting; if empty, in proportion to income
+	Ratios map[string]quantity.Percentage `json:"ratios,omitempty"` // Share of each member, if split by ratio
+}
+
+// Household describes a named household of members, each with a budget of their own, who share some expenses
+type Household struct {
+	name     string
+	Currency quantity.Currency `json:"currency,omitempty"` // Currency the household is reported in; if empty, quantity.DefaultCurrency
+	Members  []string          `json:"members"`            // Names of the budgets of the members
+	Shared   ExpenseList       `json:"shared"`             // Expenses shared between members
+	Split    Split             `json:"split"`
+}
+
+// MakeHousehold makes a named household of the given members
+func MakeHousehold(name string, members []string) *Household {
+	return &Household{
+		name:    name,
+		Members: members,
+		Shared:  make(ExpenseList),
+	}
+}
+
+// LoadHousehold loads a household from disk
+func LoadHousehold(name string) (*Household, error) {
+	household := MakeHousehold(name, nil)
+
+	fileReader, err := os.Open(fmt.Sprintf("%s.household", name))
+	if err != nil {
+		return nil, err
+	}
+	defer fileReader.Close()
+
+	decoder := json.NewDecoder(fileReader)
+	if err := decoder.Decode(household); err != nil {
+		return nil, err
+	}
+
+	return household, nil
+}
+
+// Save saves a household to disk
+func (household *Household) Save() error {
+	fileWriter, err := os.Create(fmt.Sprintf("%s.household", household.name))
+	if err != nil {
+		return err
+	}
+	defer fileWriter.Close()
+
+	encoder := json.NewEncoder(fileWriter)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(household); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReportingCurrency returns the currency the household is reported in
+func (household *Household) ReportingCurrency() quantity.Currency {
+	return household.Currency.Or(quantity.DefaultCurrency)
+}
+
+// NewSplit makes a split by the given method. Splits by ratio take the share of each member, which must add up to 100%.
+func (household *Household) NewSplit(method string, ratios map[string]quantity.Percentage) (Split, error) {
+	switch method {
+	case IncomeSplit, EqualSplit:
+		return Split{Method: method}, nil
+	case RatioSplit:
+		var total float64
+		for _, member := range household.Members {
+			ratio, ok := ratios[member]
+			if !ok {
+				return Split{}, fmt.Errorf(`no share given for member "%s"`, member)
+			}
+			total += ratio.ValueOf()
+		}
+		if len(ratios) != len(household.Members) {
+			return Split{}, fmt.Errorf("shares given for budgets that are not members")
+		}
+		if math.Abs(total-1) > 1e-9 {
+			return Split{}, fmt.Errorf("the shares of members add up to %s, rather than 100%%", quantity.Percentage(total))
+		}
+		return Split{Method: RatioSplit, Ratios: ratios}, nil
+	default:
+		return Split{}, fmt.Errorf(`unknown method of splitting "%s"; expected income, equal or shares by ratio`, method)
+	}
+}
+
+// LoadMembers loads the budgets of the members of the household
+func (household *Household) LoadMembers() (map[string]*Budget, error) {
+	members := make(map[string]*Budget, len(household.Members))
+	for _, member := range household.Members {
+		memberBudget, err := Load(member)
+		if err != nil {
+			return nil, fmt.Errorf(`could not load budget "%s.budget" of member "%s": %w`, member, member, err)
+		}
+		members[member] = memberBudget
+	}
+	return members, nil
+}
+
+// MemberShare describes what a member of a household earns, spends on their own and contributes to shared expenses, per month in
+// the currency of the household
+type MemberShare struct {
+	Name         string
+	Income       quantity.Money
+	Expenses     quantity.Money      // Expenses of the member's own budget
+	Share        quantity.Percentage // Share of the shared expenses
+	Contribution quantity.Money      // Amount contributed to the shared expenses
+}
+
+// Remaining returns the amount the member has remaining after their own expenses and their contribution
+func (share MemberShare) Remaining() quantity.Money {
+	return share.Income - share.Expenses - share.Contribution
+}
+
+// Shares splits the shared expenses of the household between its members. Splits in proportion to income are split equally if no
+// member has any income.
+func (household *Household) Shares(members map[string]*Budget) []MemberShare {
+	currency := household.ReportingCurrency()
+	shared := household.Shared.Sum(currency)
+
+	shares := make([]MemberShare, 0, len(household.Members))
+	var totalIncome quantity.Money
+	for _, member := range household.Members {
+		memberBudget := members[member]
+		memberCurrency := memberBudget.ReportingCurrency()
+		share := MemberShare{
+			Name:     member,
+			Income:   Convert(memberBudget.Income.Sum(memberCurrency), memberCurrency, currency, time.Now()),
+			Expenses: Convert(memberBudget.Expenses.Sum(memberCurrency), memberCurrency, currency, time.Now()),
+		}
+		totalIncome += share.Income
+		shares = append(shares, share)
+	}
+
+	for index := range shares {
+		switch {
+		case household.Split.Method == RatioSplit:
+			shares[index].Share = household.Split.Ratios[shares[index].Name]
+		case household.Split.Method == EqualSplit, totalIncome <= 0:
+			shares[index].Share = quantity.Percentage(1 / float64(len(shares)))
+		default:
+			shares[index].Share = quantity.Percentage(shares[index].Income.ValueOf() / totalIncome.ValueOf())
+		}
+		shares[index].Contribution = quantity.Money(shared.ValueOf() * shares[index].Share.ValueOf())
+	}
+	return shares
+}
+
+// Combine merges the budgets of the members of the household into one budget, in the currency of the household. Income sources and
+// expenses of members are named after their member, as in "alice: Salary", so that those of the same name do not collide, and
+// shared expenses keep their own names.
+func (household *Household) Combine(members map[string]*Budget) *Budget {
+	combined := Make(household.name)
+	combined.Currency = household.Currency
+	for _, member := range household.Members {
+		memberBudget := members[member]
+		for name, income := range memberBudget.Income {
+			memberIncome := copyIncome(income)
+			memberIncome.Attributes().Currency = income.Attributes().Currency.Or(memberBudget.ReportingCurrency())
+			combined.Income[memberItemName(member, name)] = memberIncome
+		}
+		for name, expense := range memberBudget.Expenses {
+			memberExpense := *expense
+			memberExpense.Currency = expense.Currency.Or(memberBudget.ReportingCurrency())
+			combined.Expenses[memberItemName(member, name)] = &memberExpense
+		}
+		combined.Savings += Convert(memberBudget.Savings, memberBudget.ReportingCurrency(), household.ReportingCurrency(), time.Now())
+	}
+	for name, expense := range household.Shared {
+		combined.Expenses[name] = expense
+	}
+	return combined
+}
+
+// memberItemName names an income source or expense of a member of a household
+func memberItemName(member string, name string) string {
+	return fmt.Sprintf("%s: %s", member, name)
+}
+
+// copyIncome returns a copy of an income source
+func copyIncome(income Income) Income {
+	value := reflect.ValueOf(income).Elem()
+	copied := reflect.New(value.Type())
+	copied.Elem().Set(value)
+	return copied.Interface().(Income)
+}
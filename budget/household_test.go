@@ -0,0 +1,59 @@
+package budget
+
+import (
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestShares(t *testing.T) {
+	household := MakeHousehold("test", []string{"alex", "sam"})
+	household.Shared["rent"] = &Expense{Amount: 2000}
+
+	alex, sam := Make("alex"), Make("sam")
+	alex.Income["job"] = &Supplemental{Money: 6000}
+	alex.Expenses["car"] = &Expense{Amount: 500}
+	sam.Income["job"] = &Supplemental{Money: 2000}
+	members := map[string]*Budget{"alex": alex, "sam": sam}
+
+	for _, test := range []struct {
+		split  Split
+		shares [2]quantity.Percentage
+	}{
+		{Split{}, [2]quantity.Percentage{0.75, 0.25}},
+		{Split{Method: IncomeSplit}, [2]quantity.Percentage{0.75, 0.25}},
+		{Split{Method: EqualSplit}, [2]quantity.Percentage{0.5, 0.5}},
+		{Split{Method: RatioSplit, Ratios: map[string]quantity.Percentage{"alex": 0.6, "sam": 0.4}}, [2]quantity.Percentage{0.6, 0.4}},
+	} {
+		household.Split = test.split
+		shares := household.Shares(members)
+		for index, want := range test.shares {
+			share := shares[index]
+			if math.Abs(share.Share.ValueOf()-want.ValueOf()) > 1e-9 || math.Abs(share.Contribution.ValueOf()-2000*want.ValueOf()) > 1e-9 {
+				t.Errorf("split by %q, %s: got a share of %s contributing %v, want %s", test.split.Method, share.Name, share.Share, share.Contribution, want)
+			}
+		}
+		if remaining := shares[0].Remaining(); math.Abs(remaining.ValueOf()-(6000-500-2000*test.shares[0].ValueOf())) > 1e-9 {
+			t.Errorf("split by %q: alex has %v remaining", test.split.Method, remaining)
+		}
+	}
+
+	// Without any income, splits in proportion to income are split equally
+	household.Split = Split{Method: IncomeSplit}
+	shares := household.Shares(map[string]*Budget{"alex": Make("alex"), "sam": Make("sam")})
+	for _, share := range shares {
+		if share.Share != 0.5 || share.Contribution != 1000 {
+			t.Errorf("without income, %s: got a share of %s contributing %v, want 50%% contributing 1000", share.Name, share.Share, share.Contribution)
+		}
+	}
+}
+
+func TestLoadMembersWrapsErrors(t *testing.T) {
+	household := MakeHousehold("test", []string{"no such member of a household"})
+	if _, err := household.LoadMembers(); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got %v, want an error wrapping os.ErrNotExist", err)
+	}
+}
@@ -4,13 +4,41 @@ import (
 	"encoding/json"
 	"errors"
 	"sort"
+	"time"
 
 	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
 // Income describes a source of monthly income
 type Income interface {
-	MonthlyIncome() quantity.Money
+	MonthlyIncome() quantity.Money // Amount received per month, in the currency of the income source
+	Attributes() *IncomeAttributes // Attributes common to all income sources
+}
+
+// IncomeAttributes describes the attributes common to all income sources
+type IncomeAttributes struct {
+	Currency  quantity.Currency `json:"currency,omitempty"`   // Currency paid in; if empty, the currency of the budget
+	PaidEvery *quantity.Period  `json:"paid_every,omitempty"` // Period between paychecks, such as 2 weeks; if empty, unknown
+	PaidOn    *Date             `json:"paid_on,omitempty"`    // Date of any paycheck, which later paychecks follow from; if empty, unknown
+	Schedule
+	Raise      quantity.Percentage `json:"raise,omitempty"`       // Raise received every raise period
+	RaiseEvery *quantity.Period    `json:"raise_every,omitempty"` // Period between raises; if empty, a year
+	RaisedOn   *Date               `json:"raised_on,omitempty"`   // Date of any raise, which later raises follow from; if empty, the start date
+	Varies     *Distribution       `json:"varies,omitempty"`      // How the amount received per month varies, for simulations; if empty, it is fixed
+	netPay     *float64            // Share of gross pay assumed to be received, as by a scenario; if empty, NetPayPercentage
+}
+
+// Attributes implements Income for IncomeAttributes
+func (attributes *IncomeAttributes) Attributes() *IncomeAttributes {
+	return attributes
+}
+
+// netPayPercentage returns the share of gross pay assumed to be received by the income source
+func (attributes *IncomeAttributes) netPayPercentage() float64 {
+	if attributes.netPay != nil {
+		return *attributes.netPay
+	}
+	return NetPayPercentage
 }
 
 // IncomeList is a list of named monthly income sources
@@ -26,15 +54,28 @@ func (list IncomeList) SortedNames() []string {
 	return names
 }
 
-// Sum adds all income sources together
-func (list IncomeList) Sum() quantity.Money {
+// Sum adds all income sources together in the given currency. Income sources without a currency are assumed to be in the given currency.
+func (list IncomeList) Sum(currency quantity.Currency) quantity.Money {
 	var total quantity.Money
-	for _, income := range list {
-		total += income.MonthlyIncome()
+	for name := range list {
+		total += list.Converted(name, currency)
 	}
 	return total
 }
 
+// Amount returns the monthly amount of the named income source in its own currency, which is the given currency of the budget if
+// unspecified
+func (list IncomeList) Amount(name string, currency quantity.Currency) quantity.Amount {
+	income := list[name]
+	return quantity.Amount{Money: income.MonthlyIncome(), Currency: income.Attributes().Currency.Or(currency)}
+}
+
+// Converted returns the monthly amount of the named income source in the given currency, as of today
+func (list IncomeList) Converted(name string, currency quantity.Currency) quantity.Money {
+	income := list[name]
+	return Convert(income.MonthlyIncome(), income.Attributes().Currency, currency, time.Now())
+}
+
 // unmarshalIncomeJSON unmarshals JSON into an Income
 func unmarshalIncomeJSON(incomeJSON json.RawMessage) (Income, error) {
 	// Try Wages
@@ -72,7 +113,7 @@ func unmarshalIncomeJSON(incomeJSON json.RawMessage) (Income, error) {
 		Volume []float64 `json:"volume"`
 	}
 	json.Unmarshal(incomeJSON, &commissionsJSON)
-	if commissionsJSON.Rate != nil && len(commissionsJSON.Volume) > 0 {
+	if commissionsJSON.Rate != nil && commissionsJSON.Volume != nil {
 		volumeMoney := make([]quantity.Money, 0, len(commissionsJSON.Volume))
 		for _, volume := range commissionsJSON.Volume {
 			volumeMoney = append(volumeMoney, quantity.Money(volume))
@@ -100,8 +141,14 @@ func (list *IncomeList) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if *list == nil {
+		*list = make(IncomeList)
+	}
 	for name, incomeJSON := range incomeListJSON {
 		if income, err := unmarshalIncomeJSON(incomeJSON); err == nil {
+			if err := json.Unmarshal(incomeJSON, income.Attributes()); err != nil {
+				return err
+			}
 			(*list)[name] = income
 		} else {
 			return err
@@ -116,6 +163,7 @@ func (list *IncomeList) UnmarshalJSON(data []byte) error {
 // additional overtime amount of $135, netting a total of $25,740 per year, or $2,145
 // per month.
 type Wages struct {
+	IncomeAttributes
 	Rate  quantity.Money  `survey:"rate" json:"rate"`   // Rate paid per hour
 	Hours quantity.Number `survey:"hours" json:"hours"` // Hours worked in one week
 }
@@ -130,18 +178,20 @@ func (income *Wages) MonthlyIncome() quantity.Money {
 		normalHours = income.Hours.ValueOf()
 		overtimeHours = 0
 	}
-	return quantity.Money(NetPayPercentage * 52 * (income.Rate.ValueOf()*normalHours + 1.5*income.Rate.ValueOf()*overtimeHours) / 12)
+	weeklyPay := quantity.Money(income.netPayPercentage() * (income.Rate.ValueOf()*normalHours + 1.5*income.Rate.ValueOf()*overtimeHours))
+	return quantity.Rate{Money: weeklyPay, Per: quantity.Week}.Monthly()
 }
 
 // Salary describes an income source that is paid as a fixed amount per year over regular intervals.
 // Example: You earn $50,000 a year as a Mathematics Professor, and earn $4,166.67 per month.
 type Salary struct {
+	IncomeAttributes
 	Salary quantity.Money `survey:"salary" json:"salary"`
 }
 
 // MonthyIncome implements Income for Salary
 func (income Salary) MonthlyIncome() quantity.Money {
-	return quantity.Money(NetPayPercentage * income.Salary.ValueOf() / 12)
+	return quantity.Rate{Money: quantity.Money(income.netPayPercentage() * income.Salary.ValueOf()), Per: quantity.Year}.Monthly()
 }
 
 // Sales describes an income source that is paid a fixed amount per item sold or task completed.
@@ -149,6 +199,7 @@ func (income Salary) MonthlyIncome() quantity.Money {
 // Example: You sell 50 cups of lemonade on average each month at a lemonade stand for $1 per cup, so your monthly
 // income would roughly be $50 per month, or $600 per year.
 type Sales struct {
+	IncomeAttributes
 	Rate  quantity.Money   `survey:"rate" json:"rate"`   // Amount paid per item
 	Items quantity.Integer `survey:"items" json:"items"` // Average count of items sold/tasks completed per month
 }
@@ -162,6 +213,7 @@ func (income Sales) MonthlyIncome() quantity.Money {
 // Example: You are a realtor and you make 6% on each home you sell. You sold 2 homes - one for $25,000 and one for $75,000.
 // Your monthly income for this month would be $6,000
 type Commissions struct {
+	IncomeAttributes
 	Rate   quantity.Percentage `survey:"rate" json:"rate"`     // Percentage for each item sold/task completed
 	Volume []quantity.Money    `survey:"volume" json:"volume"` // Value of each item sold/task completed
 }
@@ -178,6 +230,7 @@ func (income Commissions) MonthlyIncome() quantity.Money {
 // Supplemental describes a generic monthly income source.
 // Example: You receive $100 per month in allowance.
 type Supplemental struct {
+	IncomeAttributes
 	Money quantity.Money `survey:"money" json:"money"`
 }
 
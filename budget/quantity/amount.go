@@ -0,0 +1,84 @@
+package quantity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Amount describes money in an ISO 4217 currency, such as €15.00
+type Amount struct {
+	Money    Money    // Value of the amount
+	Currency Currency // Currency of the amount; if empty, the currency is unspecified
+}
+
+// NewAmount transforms the given value into an Amount, if possible; otherwise, this returns an error. Strings are written as in ParseMoney,
+// so "€15" is 15 in EUR, while numbers have no currency.
+func NewAmount(value interface{}) (Amount, error) {
+	switch amountValue := value.(type) {
+	case Amount:
+		return amountValue, nil
+	case string:
+		money, currency, err := ParseMoney(amountValue)
+		return Amount{Money: money, Currency: currency}, err
+	default:
+		money, err := NewMoney(value)
+		return Amount{Money: money}, err
+	}
+}
+
+// MakeAmount transforms the given value into an Amount; otherwise, this panics
+func MakeAmount(value interface{}) Amount {
+	if amount, err := NewAmount(value); err == nil {
+		return amount
+	} else {
+		panic(err)
+	}
+}
+
+// In returns the amount, written in the given currency if its own is unspecified. If the amount was written in another currency,
+// this returns an error, as amounts can only be converted with exchange rates.
+func (amount Amount) In(currency Currency) (Amount, error) {
+	if amount.Currency == "" || amount.Currency == currency {
+		return Amount{Money: amount.Money, Currency: currency}, nil
+	}
+	return amount, fmt.Errorf(`amount %s is written in %s, not %s`, amount, amount.Currency, currency)
+}
+
+// ValueOf implements Quantity for Amount
+func (amount Amount) ValueOf() float64 {
+	return amount.Money.ValueOf()
+}
+
+// IsFinite reports whether the value of the amount is known, which it is not when it could not be converted from another currency
+func (amount Amount) IsFinite() bool {
+	return !math.IsNaN(amount.ValueOf()) && !math.IsInf(amount.ValueOf(), 0)
+}
+
+// String implements fmt.Stringer for Amount, written in its own currency
+func (amount Amount) String() string {
+	return amount.Money.Format(amount.Currency)
+}
+
+// MarshalJSON implements json.Marshaler for Amount. Amounts whose value is unknown are written as null, since JSON has no NaN.
+func (amount Amount) MarshalJSON() ([]byte, error) {
+	var money *float64
+	if amount.IsFinite() {
+		value := amount.ValueOf()
+		money = &value
+	}
+	return json.Marshal(struct {
+		Amount   *float64 `json:"amount"`
+		Currency string   `json:"currency"`
+	}{money, amount.Currency.String()})
+}
+
+// WriteAnswer implements survey.core.Settable for Amount
+func (amount *Amount) WriteAnswer(field string, value interface{}) error {
+	if amountValue, err := NewAmount(value); err == nil {
+		*amount = amountValue
+	} else {
+		return err
+	}
+	return nil
+}
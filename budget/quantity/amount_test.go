@@ -0,0 +1,57 @@
+package quantity
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestNewAmount(t *testing.T) {
+	for _, test := range []struct {
+		value interface{}
+		want  Amount
+	}{
+		{"€15", Amount{Money: 15, Currency: "EUR"}},
+		{"1,200 GBP", Amount{Money: 1200, Currency: "GBP"}},
+		{"$19.99", Amount{Money: 19.99, Currency: "USD"}},
+		{"42", Amount{Money: 42}},
+		{12.5, Amount{Money: 12.5}},
+	} {
+		got, err := NewAmount(test.value)
+		if err != nil {
+			t.Errorf("%v: %s", test.value, err)
+		} else if got != test.want {
+			t.Errorf("%v: got %#v, want %#v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestAmountIn(t *testing.T) {
+	if got, err := MakeAmount("42").In("EUR"); err != nil || got != (Amount{Money: 42, Currency: "EUR"}) {
+		t.Errorf("amount without a currency: got %#v, %v", got, err)
+	}
+	if got, err := MakeAmount("€42").In("EUR"); err != nil || got != (Amount{Money: 42, Currency: "EUR"}) {
+		t.Errorf("amount in the same currency: got %#v, %v", got, err)
+	}
+	if _, err := MakeAmount("$42").In("EUR"); err == nil {
+		t.Error("an amount in USD was taken as EUR")
+	}
+}
+
+func TestAmountMarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		amount Amount
+		want   string
+	}{
+		{Amount{Money: 15, Currency: "EUR"}, `{"amount":15,"currency":"EUR"}`},
+		{Amount{Money: Money(math.NaN()), Currency: "USD"}, `{"amount":null,"currency":"USD"}`},
+		{Amount{Money: Money(math.Inf(1)), Currency: "USD"}, `{"amount":null,"currency":"USD"}`},
+	} {
+		got, err := json.Marshal(test.amount)
+		if err != nil {
+			t.Errorf("%#v: %s", test.amount, err)
+		} else if string(got) != test.want {
+			t.Errorf("%#v: got %s, want %s", test.amount, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package quantity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Currency describes an ISO 4217 currency by its code
+type Currency string
+
+// currencyInfo describes how amounts of a currency are written
+type currencyInfo struct {
+	symbol   string // Symbol written before amounts
+	decimals int    // Number of digits of minor units
+}
+
+// DefaultCurrency is the currency of amounts that do not specify one
+var DefaultCurrency Currency = "USD"
+
+var currencies = map[Currency]currencyInfo{
+	"AUD": {symbol: "A$", decimals: 2},
+	"BRL": {symbol: "R$", decimals: 2},
+	"CAD": {symbol: "CA$", decimals: 2},
+	"CHF": {symbol: "CHF ", decimals: 2},
+	"CNY": {symbol: "CN¥", decimals: 2},
+	"DKK": {symbol: "kr. ", decimals: 2},
+	"EUR": {symbol: "€", decimals: 2},
+	"GBP": {symbol: "£", decimals: 2},
+	"INR": {symbol: "₹", decimals: 2},
+	"JPY": {symbol: "¥", decimals: 0},
+	"KRW": {symbol: "₩", decimals: 0},
+	"MXN": {symbol: "MX$", decimals: 2},
+	"NOK": {symbol: "kr ", decimals: 2},
+	"NZD": {symbol: "NZ$", decimals: 2},
+	"PLN": {symbol: "zł ", decimals: 2},
+	"SEK": {symbol: "kr ", decimals: 2},
+	"USD": {symbol: "$", decimals: 2},
+}
+
+// currencySymbols maps unambiguous symbols to their currency
+var currencySymbols = map[string]Currency{
+	"$":   "USD",
+	"US$": "USD",
+	"€":   "EUR",
+	"£":   "GBP",
+	"¥":   "JPY",
+	"₹":   "INR",
+	"₩":   "KRW",
+	"A$":  "AUD",
+	"AU$": "AUD",
+	"C$":  "CAD",
+	"CA$": "CAD",
+	"CN¥": "CNY",
+	"MX$": "MXN",
+	"NZ$": "NZD",
+	"R$":  "BRL",
+	"zł":  "PLN",
+}
+
+// NewCurrency transforms the given value, either an ISO 4217 code or a symbol, into a Currency, if possible; otherwise, this returns an error
+func NewCurrency(value interface{}) (Currency, error) {
+	switch currencyValue := value.(type) {
+	case Currency:
+		return NewCurrency(string(currencyValue))
+	case nil:
+		return DefaultCurrency, nil
+	case string:
+		currencyValue = strings.TrimSpace(currencyValue)
+		if currency, ok := currencySymbols[currencyValue]; ok {
+			return currency, nil
+		}
+		code := Currency(strings.ToUpper(currencyValue))
+		if _, ok := currencies[code]; ok {
+			return code, nil
+		}
+		return "", fmt.Errorf(`failed to parse string %s as budget.Currency: unknown currency`, currencyValue)
+	default:
+		return "", fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Currency: invalid type`, value)
+	}
+}
+
+// MakeCurrency transforms the given value into a Currency, if possible; otherwise, this panics
+func MakeCurrency(value interface{}) Currency {
+	if currency, err := NewCurrency(value); err == nil {
+		return currency
+	} else {
+		panic(err)
+	}
+}
+
+// Currencies returns the codes of all known currencies, sorted lexographically
+func Currencies() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, string(code))
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Or returns the currency, or the given fallback if the currency is unspecified
+func (currency Currency) Or(fallback Currency) Currency {
+	if currency == "" {
+		return fallback
+	}
+	return currency
+}
+
+// Symbol returns the symbol written before amounts of the currency
+func (currency Currency) Symbol() string {
+	if info, ok := currencies[currency.Or(DefaultCurrency)]; ok {
+		return info.symbol
+	}
+	return string(currency) + " "
+}
+
+// Decimals returns the number of digits of minor units of the currency
+func (currency Currency) Decimals() int {
+	if info, ok := currencies[currency.Or(DefaultCurrency)]; ok {
+		return info.decimals
+	}
+	return 2
+}
+
+// String implements fmt.Stringer for Currency
+func (currency Currency) String() string {
+	return string(currency.Or(DefaultCurrency))
+}
+
+// WriteAnswer implements survey.core.Settable for Currency
+func (currency *Currency) WriteAnswer(field string, value interface{}) error {
+	if currencyValue, err := NewCurrency(value); err == nil {
+		*currency = currencyValue
+	} else {
+		return err
+	}
+	return nil
+}
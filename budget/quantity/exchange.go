@@ -0,0 +1,140 @@
+package quantity
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExchangeRate describes how much one unit of a currency is worth in another currency as of a date
+type ExchangeRate struct {
+	Date time.Time
+	From Currency
+	To   Currency
+	Rate float64
+}
+
+// ExchangeRates is a table of dated exchange rates
+type ExchangeRates struct {
+	rates []ExchangeRate
+}
+
+// NewExchangeRates makes a table of the given exchange rates
+func NewExchangeRates(rates ...ExchangeRate) *ExchangeRates {
+	table := &ExchangeRates{rates: append([]ExchangeRate(nil), rates...)}
+	sort.SliceStable(table.rates, func(i, j int) bool {
+		return table.rates[i].Date.Before(table.rates[j].Date)
+	})
+	return table
+}
+
+// ReadExchangeRates reads a table of exchange rates from CSV records of the form "date,from,to,rate", as in
+// "2026-09-01,EUR,USD,1.08". Blank lines and lines starting with # are ignored.
+func ReadExchangeRates(reader io.Reader) (*ExchangeRates, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 4
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]ExchangeRate, 0, len(records))
+	for index, record := range records {
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate %d: invalid date %q", index+1, record[0])
+		}
+		from, err := NewCurrency(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate %d: %w", index+1, err)
+		}
+		to, err := NewCurrency(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate %d: %w", index+1, err)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("exchange rate %d: invalid rate %q", index+1, record[3])
+		}
+		rates = append(rates, ExchangeRate{Date: date, From: from, To: to, Rate: rate})
+	}
+
+	return NewExchangeRates(rates...), nil
+}
+
+// LoadExchangeRates loads a table of exchange rates from the given file, as read by ReadExchangeRates.
+// If the file does not exist, an empty table is returned.
+func LoadExchangeRates(path string) (*ExchangeRates, error) {
+	fileReader, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewExchangeRates(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fileReader.Close()
+
+	return ReadExchangeRates(fileReader)
+}
+
+// direct returns the latest rate from one currency to another as of the given date, using inverse rates if needed
+func (table *ExchangeRates) direct(from, to Currency, date time.Time) (float64, bool) {
+	if table == nil {
+		return 0, false
+	}
+	for index := len(table.rates) - 1; index >= 0; index-- {
+		rate := table.rates[index]
+		if rate.Date.After(date) {
+			continue
+		}
+		if rate.From == from && rate.To == to {
+			return rate.Rate, true
+		} else if rate.From == to && rate.To == from {
+			return 1 / rate.Rate, true
+		}
+	}
+	return 0, false
+}
+
+// Rate returns the latest rate from one currency to another as of the given date. When there is no rate
+// between the two currencies, a rate through a third currency is used instead.
+func (table *ExchangeRates) Rate(from, to Currency, date time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	if rate, ok := table.direct(from, to, date); ok {
+		return rate, nil
+	}
+	if table != nil {
+		for _, code := range Currencies() {
+			through := Currency(code)
+			if through == from || through == to {
+				continue
+			}
+			if first, ok := table.direct(from, through, date); ok {
+				if second, ok := table.direct(through, to, date); ok {
+					return first * second, nil
+				}
+			}
+		}
+	}
+	return math.NaN(), fmt.Errorf("no exchange rate from %s to %s as of %s", from, to, date.Format("2006-01-02"))
+}
+
+// Convert converts money from one currency to another as of the given date
+func (table *ExchangeRates) Convert(money Money, from, to Currency, date time.Time) (Money, error) {
+	rate, err := table.Rate(from, to, date)
+	if err != nil {
+		return Money(math.NaN()), err
+	}
+	return Money(money.ValueOf() * rate), nil
+}
@@ -0,0 +1,272 @@
+package quantity
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	errDivisionByZero     = errors.New("division by zero")
+	errUnbalanced         = errors.New("unbalanced parentheses")
+	errMissingOperand     = errors.New("missing operand")
+	errUnexpectedCurrency = errors.New("unexpected currency")
+	errMixedCurrencies    = errors.New("mixed currencies")
+	errNotWhole           = errors.New("result is not an integer")
+)
+
+// expressionToken describes a token of an arithmetic expression
+type expressionToken struct {
+	kind  rune    // One of '0' for numbers, '$' for currencies, or the operator or parenthesis itself
+	value float64 // Value of numbers
+	text  string  // Text of numbers and currencies
+}
+
+// expressionParser evaluates arithmetic expressions of +, -, *, / and parentheses by recursive descent
+type expressionParser struct {
+	kind     string
+	tokens   []expressionToken
+	position int
+	currency Currency
+}
+
+// isOperator reports whether the rune is an arithmetic operator or parenthesis
+func isOperator(character rune) bool {
+	return strings.ContainsRune("+-*/×÷()", character)
+}
+
+// tokenize splits an arithmetic expression written in the locale into tokens. Numbers are parsed later, so that group separators may be
+// checked against the locale.
+func (locale *Locale) tokenize(expression string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	isGroup := func(character rune) bool {
+		if strings.TrimSpace(locale.Group) == "" {
+			return unicode.IsSpace(character)
+		}
+		return strings.ContainsRune(locale.Group, character)
+	}
+	isDecimal := func(character rune) bool {
+		return strings.ContainsRune(locale.Decimal, character)
+	}
+	nextIsDigit := func(index int) bool {
+		next, _ := utf8.DecodeRuneInString(expression[index:])
+		return unicode.IsDigit(next)
+	}
+
+	for index := 0; index < len(expression); {
+		character, size := utf8.DecodeRuneInString(expression[index:])
+		switch {
+		case unicode.IsSpace(character):
+			index += size
+		case isOperator(character):
+			switch character {
+			case '×':
+				character = '*'
+			case '÷':
+				character = '/'
+			}
+			tokens = append(tokens, expressionToken{kind: character, text: string(character)})
+			index += size
+		case unicode.IsDigit(character) || (isDecimal(character) && nextIsDigit(index+size)):
+			start := index
+			for index < len(expression) {
+				character, size := utf8.DecodeRuneInString(expression[index:])
+				if unicode.IsDigit(character) || ((isDecimal(character) || isGroup(character)) && nextIsDigit(index+size)) {
+					index += size
+				} else {
+					break
+				}
+			}
+			tokens = append(tokens, expressionToken{kind: '0', text: expression[start:index]})
+		default:
+			start := index
+			for index < len(expression) {
+				character, size := utf8.DecodeRuneInString(expression[index:])
+				if unicode.IsSpace(character) || isOperator(character) || unicode.IsDigit(character) {
+					break
+				}
+				index += size
+			}
+			tokens = append(tokens, expressionToken{kind: '$', text: expression[start:index]})
+		}
+	}
+
+	for index, token := range tokens {
+		if token.kind != '0' {
+			continue
+		}
+		value, err := locale.parse(token.text, "number")
+		if err != nil {
+			return nil, err
+		}
+		tokens[index].value = value
+	}
+
+	return tokens, nil
+}
+
+// IsExpression reports whether the value is an arithmetic expression of several quantities written in DefaultLocale, such as
+// "45.99 + 12.50", "1200/12" or "3 * $19.99", rather than a single quantity
+func IsExpression(value string) bool {
+	tokens, err := DefaultLocale.tokenize(value)
+	if err != nil {
+		return false
+	}
+	var numbers int
+	for _, token := range tokens {
+		if token.kind == '0' {
+			numbers++
+		}
+	}
+	return numbers > 1
+}
+
+// peek returns the kind of the next token, or 0 at the end of the expression
+func (parser *expressionParser) peek() rune {
+	if parser.position < len(parser.tokens) {
+		return parser.tokens[parser.position].kind
+	}
+	return 0
+}
+
+// expression parses terms separated by + and -
+func (parser *expressionParser) expression() (float64, error) {
+	result, err := parser.term()
+	if err != nil {
+		return math.NaN(), err
+	}
+	for operator := parser.peek(); operator == '+' || operator == '-'; operator = parser.peek() {
+		parser.position++
+		operand, err := parser.term()
+		if err != nil {
+			return math.NaN(), err
+		}
+		if operator == '+' {
+			result += operand
+		} else {
+			result -= operand
+		}
+	}
+	return result, nil
+}
+
+// term parses factors separated by * and /
+func (parser *expressionParser) term() (float64, error) {
+	result, err := parser.factor()
+	if err != nil {
+		return math.NaN(), err
+	}
+	for operator := parser.peek(); operator == '*' || operator == '/'; operator = parser.peek() {
+		parser.position++
+		operand, err := parser.factor()
+		if err != nil {
+			return math.NaN(), err
+		}
+		if operator == '*' {
+			result *= operand
+		} else if operand == 0 {
+			return math.NaN(), errDivisionByZero
+		} else {
+			result /= operand
+		}
+	}
+	return result, nil
+}
+
+// factor parses signed numbers and parenthesized expressions, along with any currency written around them
+func (parser *expressionParser) factor() (float64, error) {
+	if err := parser.currencies(); err != nil {
+		return math.NaN(), err
+	}
+
+	var result float64
+	switch parser.peek() {
+	case '+', '-':
+		negative := parser.peek() == '-'
+		parser.position++
+		operand, err := parser.factor()
+		if err != nil {
+			return math.NaN(), err
+		}
+		if negative {
+			operand = -operand
+		}
+		return operand, nil
+	case '(':
+		parser.position++
+		operand, err := parser.expression()
+		if err != nil {
+			return math.NaN(), err
+		}
+		if parser.peek() != ')' {
+			return math.NaN(), errUnbalanced
+		}
+		parser.position++
+		result = operand
+	case '0':
+		result = parser.tokens[parser.position].value
+		parser.position++
+	case ')':
+		return math.NaN(), errUnbalanced
+	default:
+		return math.NaN(), errMissingOperand
+	}
+
+	if err := parser.currencies(); err != nil {
+		return math.NaN(), err
+	}
+	return result, nil
+}
+
+// currencies skips any currency tokens, checking that they agree with the currency of the expression
+func (parser *expressionParser) currencies() error {
+	for parser.peek() == '$' {
+		if parser.kind != "money" {
+			return errUnexpectedCurrency
+		}
+		currency, err := NewCurrency(parser.tokens[parser.position].text)
+		if err != nil {
+			return err
+		}
+		if parser.currency != "" && parser.currency != currency {
+			return errMixedCurrencies
+		}
+		parser.currency = currency
+		parser.position++
+	}
+	return nil
+}
+
+// evaluate evaluates an arithmetic expression written in the locale, where kind is either "integer", "number" or "money". Money is rounded
+// to the minor units of its currency, and integers must evaluate to whole numbers.
+func (locale *Locale) evaluate(expression string, kind string) (float64, Currency, error) {
+	tokens, err := locale.tokenize(expression)
+	if err != nil {
+		return math.NaN(), "", err
+	}
+
+	parser := &expressionParser{kind: kind, tokens: tokens}
+	result, err := parser.expression()
+	if err != nil {
+		return math.NaN(), "", err
+	}
+	if parser.position < len(parser.tokens) {
+		if parser.peek() == ')' {
+			return math.NaN(), "", errUnbalanced
+		}
+		return math.NaN(), "", errMissingOperand
+	}
+
+	switch kind {
+	case "money":
+		scale := math.Pow10(parser.currency.Decimals())
+		result = math.Round(result*scale) / scale
+	case "integer":
+		if result != math.Trunc(result) {
+			return math.NaN(), "", errNotWhole
+		}
+	}
+	return result, parser.currency, nil
+}
@@ -3,20 +3,13 @@ package quantity
 import (
 	"fmt"
 	"math"
-	"regexp"
-	"strconv"
-	"strings"
-)
-
-var (
-	integerRegexp        = regexp.MustCompile(`^([+\-]?\d+)$`)
-	integerGroupedRegexp = regexp.MustCompile(`^([+\-]?\d{1,3}(?:,\d{3})*)$`)
 )
 
 // Integer describes a human-friendly mathematical integer
 type Integer float64
 
-// NewInteger transforms the given value into an Integer, if possible; otherwise, this returns an error
+// NewInteger transforms the given value into an Integer, if possible; otherwise, this returns an error. Strings may be arithmetic expressions,
+// as in "4 * 12".
 func NewInteger(value interface{}) (Integer, error) {
 	switch typedValue := value.(type) {
 	case Quantity:
@@ -32,22 +25,16 @@ func NewInteger(value interface{}) (Integer, error) {
 		typedValue, _ = math.Modf(typedValue)
 		return Integer(typedValue), nil
 	case string:
-		if integerRegexp.MatchString(typedValue) {
-			typedValue = integerRegexp.FindStringSubmatch(typedValue)[0]
-			if integer, err := strconv.ParseInt(typedValue, 10, 64); err == nil {
+		if integer, err := DefaultLocale.parse(typedValue, "integer"); err == nil {
+			return Integer(integer), nil
+		} else if IsExpression(typedValue) {
+			if integer, _, err := DefaultLocale.evaluate(typedValue, "integer"); err == nil {
 				return Integer(integer), nil
 			} else {
-				return 0, fmt.Errorf(`failed to parse string %s as budget.Integer: %w`, typedValue, err)
-			}
-		} else if integerGroupedRegexp.MatchString(typedValue) {
-			typedValue = strings.ReplaceAll(integerRegexp.FindStringSubmatch(typedValue)[0], ",", "")
-			if integer, err := strconv.ParseInt(typedValue, 10, 64); err == nil {
-				return Integer(integer), nil
-			} else {
-				return 0, fmt.Errorf(`failed to parse string %s as budget.Integer: %w`, typedValue, err)
+				return 0, fmt.Errorf(`failed to evaluate string %s as budget.Integer: %w`, typedValue, err)
 			}
 		} else {
-			return 0, fmt.Errorf(`failed to parse string %s as budget.Number: invalid format`, typedValue)
+			return 0, fmt.Errorf(`failed to parse string %s as budget.Integer: %w`, typedValue, err)
 		}
 	default:
 		return Integer(math.NaN()), fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Integer: invalid type`, value)
@@ -78,54 +65,29 @@ func (integer Integer) IsNaN() bool {
 	return math.IsNaN(integer.ValueOf())
 }
 
-// Ordinal returns the ordinal number that corresponds to the value of Integer. Returns an empty string if negative.
+// Ordinal returns the ordinal number that corresponds to the value of Integer in DefaultLocale. Returns an empty string if negative.
 func (integer Integer) Ordinal() string {
-	var builder strings.Builder
-
 	integerValue := integer.ValueOf()
-	if integerValue >= 0 {
-		builder.WriteString(strconv.FormatFloat(integerValue, 'f', 0, 64))
-		if integerValue > 10 && integerValue < 20 {
-			builder.WriteString("th")
-		} else {
-			switch math.Mod(integerValue, 10) {
-			case 1:
-				builder.WriteString("st")
-			case 2:
-				builder.WriteString("nd")
-			case 3:
-				builder.WriteString("rd")
-			default:
-				builder.WriteString("th")
-			}
-		}
+	if integerValue < 0 || math.IsNaN(integerValue) || math.IsInf(integerValue, 0) {
+		return ""
 	}
-
-	return builder.String()
+	return DefaultLocale.Ordinal(int64(integerValue))
 }
 
-// String implements fmt.Stringer for Integer
+// String implements fmt.Stringer for Integer, written in DefaultLocale
 func (integer Integer) String() string {
-	var builder strings.Builder
-
 	switch integerValue := integer.ValueOf(); {
 	case math.IsInf(integerValue, 1):
-		builder.WriteString("∞")
+		return "∞"
 	case math.IsInf(integerValue, -1):
-		builder.WriteString("-∞")
+		return DefaultLocale.negative("∞")
 	case math.IsNaN(integerValue):
-		builder.WriteString("?")
+		return "?"
+	case integerValue < 0:
+		return DefaultLocale.negative(DefaultLocale.format(integerValue, 0))
 	default:
-		integer := strconv.FormatFloat(integerValue, 'f', 0, 64)
-		for index, integerRune := range integer {
-			if index > 0 && (len(integer)-index)%3 == 0 {
-				builder.WriteRune(',')
-			}
-			builder.WriteRune(integerRune)
-		}
+		return DefaultLocale.format(integerValue, 0)
 	}
-
-	return builder.String()
 }
 
 // WriteAnswer implements survey.core.Settable for Integer
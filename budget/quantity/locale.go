@@ -0,0 +1,212 @@
+package quantity
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale describes how quantities are written in a language and region
+type Locale struct {
+	Tag            string             // BCP 47 tag, such as "en-US"
+	Decimal        string             // Separator of the integer and fractional parts
+	Group          string             // Separator of groups of thousands
+	CurrencyAfter  bool               // Whether currency symbols are written after amounts, as in "12,00 €"
+	PercentSpace   bool               // Whether a space is written before percent signs, as in "12 %"
+	NegativeFormat string             // Format of negative amounts, where %s is the absolute amount, such as "-%s" or "(%s)"
+	ordinal        func(int64) string // Writes the ordinal of a non-negative integer
+	groupReplacer  *strings.Replacer  // Removes group separators
+	patterns       map[string]*regexp.Regexp
+}
+
+// DefaultLocale is the locale quantities are read and written in
+var DefaultLocale = MakeLocale("en-US")
+
+// locales are the known locales. All of them write negative amounts with a leading minus sign, as their standard currency formats do,
+// though accounting writes them in parentheses instead; see WithNegativeFormat.
+var locales = map[string]*Locale{
+	"en-US": {
+		Tag:            "en-US",
+		Decimal:        ".",
+		Group:          ",",
+		NegativeFormat: "-%s",
+		ordinal:        englishOrdinal,
+	},
+	"en-GB": {
+		Tag:            "en-GB",
+		Decimal:        ".",
+		Group:          ",",
+		NegativeFormat: "-%s",
+		ordinal:        englishOrdinal,
+	},
+	"de-DE": {
+		Tag:            "de-DE",
+		Decimal:        ",",
+		Group:          ".",
+		CurrencyAfter:  true,
+		PercentSpace:   true,
+		NegativeFormat: "-%s",
+		ordinal: func(integer int64) string {
+			return fmt.Sprintf("%d.", integer)
+		},
+	},
+	"fr-FR": {
+		Tag:            "fr-FR",
+		Decimal:        ",",
+		Group:          "\u202f", // Narrow no-break space
+		CurrencyAfter:  true,
+		PercentSpace:   true,
+		NegativeFormat: "-%s",
+		ordinal: func(integer int64) string {
+			if integer == 1 {
+				return "1er"
+			}
+			return fmt.Sprintf("%de", integer)
+		},
+	},
+	"es-ES": {
+		Tag:            "es-ES",
+		Decimal:        ",",
+		Group:          ".",
+		CurrencyAfter:  true,
+		PercentSpace:   true,
+		NegativeFormat: "-%s",
+		ordinal: func(integer int64) string {
+			return fmt.Sprintf("%d.º", integer)
+		},
+	},
+}
+
+func init() {
+	for _, locale := range locales {
+		locale.compile()
+	}
+}
+
+// englishOrdinal writes an ordinal in English, such as 1st, 12th or 23rd
+func englishOrdinal(integer int64) string {
+	suffix := "th"
+	if integer%100 < 11 || integer%100 > 13 {
+		switch integer % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.FormatInt(integer, 10) + suffix
+}
+
+// compile prepares the locale for reading quantities
+func (locale *Locale) compile() {
+	group := regexp.QuoteMeta(locale.Group)
+	groups := []string{locale.Group, ""}
+	if strings.TrimSpace(locale.Group) == "" || locale.Group == " " || locale.Group == " " {
+		// Spaces of any kind are accepted as group separators when the locale groups with spaces
+		group = `[ \x{00a0}\x{202f}]`
+		groups = []string{" ", "", " ", "", " ", ""}
+	}
+	locale.groupReplacer = strings.NewReplacer(groups...)
+
+	decimal := regexp.QuoteMeta(locale.Decimal)
+	pattern := func(fraction string) *regexp.Regexp {
+		return regexp.MustCompile(`^[+\-]?(?:\d+|\d{1,3}(?:` + group + `\d{3})+)` + fraction + `$`)
+	}
+	locale.patterns = map[string]*regexp.Regexp{
+		"integer": pattern(``),
+		"number":  pattern(`(?:` + decimal + `\d+)?`),
+		"money":   pattern(`(?:` + decimal + `\d{1,2})?`),
+	}
+}
+
+// NewLocale returns the locale with the given tag, if known; otherwise, this returns an error
+func NewLocale(tag string) (*Locale, error) {
+	for knownTag, locale := range locales {
+		if strings.EqualFold(knownTag, strings.ReplaceAll(tag, "_", "-")) {
+			return locale, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown locale %q; expected one of %s", tag, strings.Join(Locales(), ", "))
+}
+
+// MakeLocale returns the locale with the given tag, if known; otherwise, this panics
+func MakeLocale(tag string) *Locale {
+	if locale, err := NewLocale(tag); err == nil {
+		return locale
+	} else {
+		panic(err)
+	}
+}
+
+// WithNegativeFormat returns a copy of the locale that writes negative amounts in the given format, where %s is the absolute amount, such
+// as "(%s)" for the parentheses of accounting. Negative amounts are read in either format.
+func (locale *Locale) WithNegativeFormat(format string) (*Locale, error) {
+	if strings.Count(format, "%s") != 1 || strings.Count(format, "%") != 1 {
+		return nil, fmt.Errorf("invalid negative format %q; expected one %%s for the absolute amount, such as -%%s or (%%s)", format)
+	}
+	copied := *locale
+	copied.NegativeFormat = format
+	return &copied, nil
+}
+
+// Locales returns the tags of all known locales, sorted lexographically
+func Locales() []string {
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// parse reads a real number written in the locale, where kind is either "integer", "number" or "money"
+func (locale *Locale) parse(value string, kind string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if !locale.patterns[kind].MatchString(value) {
+		return math.NaN(), fmt.Errorf("invalid format for %s", locale.Tag)
+	}
+	value = locale.groupReplacer.Replace(value)
+	if locale.Decimal != "." {
+		value = strings.Replace(value, locale.Decimal, ".", 1)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// format writes the absolute value of a real number in the locale, with the given number of decimals, or as few as needed if negative
+func (locale *Locale) format(value float64, decimals int) string {
+	var builder strings.Builder
+
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+	integerString, fractionString := formatted, ""
+	if index := strings.IndexRune(formatted, '.'); index >= 0 {
+		integerString, fractionString = formatted[:index], formatted[index+1:]
+	}
+
+	for index, integerRune := range integerString {
+		if index > 0 && (len(integerString)-index)%3 == 0 {
+			builder.WriteString(locale.Group)
+		}
+		builder.WriteRune(integerRune)
+	}
+	if fractionString != "" {
+		builder.WriteString(locale.Decimal)
+		builder.WriteString(fractionString)
+	}
+
+	return builder.String()
+}
+
+// negative writes an absolute amount as negative
+func (locale *Locale) negative(absolute string) string {
+	return fmt.Sprintf(locale.NegativeFormat, absolute)
+}
+
+// Ordinal writes the ordinal of a non-negative integer, such as 1st in English or 1er in French
+func (locale *Locale) Ordinal(integer int64) string {
+	return locale.ordinal(integer)
+}
@@ -0,0 +1,42 @@
+package quantity
+
+import "testing"
+
+func TestNegativeFormat(t *testing.T) {
+	defer func(locale *Locale) { DefaultLocale = locale }(DefaultLocale)
+
+	for _, test := range []struct {
+		tag    string
+		format string
+		want   string
+	}{
+		{"en-US", "", "-$1,234.50"},
+		{"en-US", "(%s)", "($1,234.50)"},
+		{"de-DE", "", "-1.234,50 $"},
+		{"de-DE", "(%s)", "(1.234,50 $)"},
+	} {
+		DefaultLocale = MakeLocale(test.tag)
+		if test.format != "" {
+			locale, err := DefaultLocale.WithNegativeFormat(test.format)
+			if err != nil {
+				t.Fatalf("%s with %q: %s", test.tag, test.format, err)
+			}
+			DefaultLocale = locale
+		}
+		if got := Money(-1234.5).Format("USD"); got != test.want {
+			t.Errorf("%s with %q: got %q, want %q", test.tag, test.format, got, test.want)
+		}
+		if money, _, err := ParseMoney(test.want); err != nil || money != -1234.5 {
+			t.Errorf("%s with %q: read %q back as %v, %v", test.tag, test.format, test.want, money, err)
+		}
+	}
+
+	if MakeLocale("en-US").NegativeFormat != "-%s" {
+		t.Error("changing the negative format changed the known locale")
+	}
+	for _, format := range []string{"", "-", "%s-%s", "%d", "(%s) %%"} {
+		if _, err := MakeLocale("en-US").WithNegativeFormat(format); err == nil {
+			t.Errorf("invalid negative format %q was accepted", format)
+		}
+	}
+}
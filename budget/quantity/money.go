@@ -3,16 +3,8 @@ package quantity
 import (
 	"fmt"
 	"math"
-	"regexp"
-	"strconv"
 	"strings"
-)
-
-var (
-	defaultRegexp        = regexp.MustCompile(`^[+\-]?\d+(?:\.\d{2})?$`)
-	defaultGroupedRegexp = regexp.MustCompile(`^[+\-]?\d{1,3}(?:,\d{3})*(?:\.\d{2})?$`)
-	usdRegexp            = regexp.MustCompile(`^([+\-]?)\$(\d+(?:\.\d{2})?)$`)
-	usdGroupedRegexp     = regexp.MustCompile(`^([+\-]?)\$(\d{1,3}(?:,\d{3})*(?:\.\d{2})?)$`)
+	"unicode"
 )
 
 // Money describes a human-friendly monetary value.
@@ -30,41 +22,83 @@ func NewMoney(value interface{}) (Money, error) {
 	case float64:
 		return Money(moneyValue), nil
 	case string:
-		if defaultRegexp.MatchString(moneyValue) {
-			if money, err := strconv.ParseFloat(moneyValue, 64); err == nil {
-				return Money(money), nil
-			} else {
-				return Money(math.NaN()), fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
-			}
-		} else if defaultGroupedRegexp.MatchString(moneyValue) {
-			moneyValue = strings.ReplaceAll(moneyValue, ",", "")
-			if money, err := strconv.ParseFloat(moneyValue, 64); err == nil {
-				return Money(money), nil
-			} else {
-				return Money(math.NaN()), fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
-			}
-		} else if usdRegexp.MatchString(moneyValue) {
-			moneyValue = strings.Join(usdRegexp.FindStringSubmatch(moneyValue)[1:], "")
-			if money, err := strconv.ParseFloat(moneyValue, 64); err == nil {
-				return Money(money), nil
-			} else {
-				return Money(math.NaN()), fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
-			}
-		} else if usdGroupedRegexp.MatchString(moneyValue) {
-			moneyValue = strings.ReplaceAll(strings.Join(usdGroupedRegexp.FindStringSubmatch(moneyValue)[1:], ""), ",", "")
-			if money, err := strconv.ParseFloat(moneyValue, 64); err == nil {
-				return Money(money), nil
-			} else {
-				return Money(math.NaN()), fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
-			}
-		} else {
-			return Money(math.NaN()), fmt.Errorf(`failed to parse string %v as budget.Money: invalid format`, value)
-		}
+		money, _, err := ParseMoney(moneyValue)
+		return money, err
 	default:
 		return Money(math.NaN()), fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Money: invalid type`, value)
 	}
 }
 
+// ParseMoney parses a string written in DefaultLocale into a Money and the Currency it was written in. The currency may be
+// written as a symbol or ISO 4217 code, before or after the amount, as in "$1,200.00", "€15", "1200 GBP" or "JPY 5,000". If no
+// currency was written, the returned Currency is empty. The string may also be an arithmetic expression, as in "3 * $19.99", which is
+// evaluated and rounded to the minor units of its currency.
+func ParseMoney(value string) (Money, Currency, error) {
+	money, currency, err := DefaultLocale.ParseMoney(value)
+	if err != nil && IsExpression(value) {
+		if evaluated, evaluatedCurrency, evaluateErr := DefaultLocale.evaluate(value, "money"); evaluateErr == nil {
+			return Money(evaluated), evaluatedCurrency, nil
+		} else {
+			return Money(math.NaN()), "", fmt.Errorf(`failed to evaluate string %v as budget.Money: %w`, value, evaluateErr)
+		}
+	}
+	return money, currency, err
+}
+
+// ParseMoney parses a string written in the locale into a Money and the Currency it was written in, as ParseMoney does for
+// DefaultLocale, though not arithmetic expressions
+func (locale *Locale) ParseMoney(value string) (Money, Currency, error) {
+	amount := strings.TrimSpace(value)
+
+	// Negative amounts may be written in parentheses, or with a sign before or after the currency
+	var negative bool
+	if strings.HasPrefix(amount, "(") && strings.HasSuffix(amount, ")") {
+		amount, negative = strings.TrimSpace(amount[1:len(amount)-1]), true
+	}
+	sign := func() {
+		if strings.HasPrefix(amount, "-") {
+			amount, negative = strings.TrimSpace(amount[1:]), !negative
+		} else if strings.HasPrefix(amount, "+") {
+			amount = strings.TrimSpace(amount[1:])
+		}
+	}
+	sign()
+
+	// Split the currency from the amount
+	start := strings.IndexFunc(amount, unicode.IsDigit)
+	end := strings.LastIndexFunc(amount, unicode.IsDigit)
+	if start < 0 {
+		return Money(math.NaN()), "", fmt.Errorf(`failed to parse string %v as budget.Money: invalid format`, value)
+	}
+	prefix, suffix := strings.TrimSpace(amount[:start]), strings.TrimSpace(amount[end+1:])
+	amount = amount[start : end+1]
+	if strings.HasSuffix(prefix, "-") || strings.HasSuffix(prefix, "+") {
+		amount = prefix[len(prefix)-1:] + amount
+		prefix = strings.TrimSpace(prefix[:len(prefix)-1])
+		sign()
+	}
+
+	var currency Currency
+	if prefix != "" && suffix != "" {
+		return Money(math.NaN()), "", fmt.Errorf(`failed to parse string %v as budget.Money: invalid format`, value)
+	} else if prefix != "" || suffix != "" {
+		parsedCurrency, err := NewCurrency(prefix + suffix)
+		if err != nil {
+			return Money(math.NaN()), "", fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
+		}
+		currency = parsedCurrency
+	}
+
+	money, err := locale.parse(amount, "money")
+	if err != nil {
+		return Money(math.NaN()), "", fmt.Errorf(`failed to parse string %v as budget.Money: %w`, value, err)
+	}
+	if negative {
+		money = -money
+	}
+	return Money(money), currency, nil
+}
+
 // MakeMoney transforms the given value into a Money; otherwise, this panics
 func MakeMoney(value interface{}) Money {
 	if money, err := NewMoney(value); err == nil {
@@ -101,36 +135,33 @@ func (money Money) Cents() Money {
 	return Money(centValue)
 }
 
-// String implements fmt.Stringer for Money
+// String implements fmt.Stringer for Money, written in DefaultCurrency
 func (money Money) String() string {
-	var builder strings.Builder
+	return money.Format(DefaultCurrency)
+}
 
+// Format writes money in the given currency and DefaultLocale
+func (money Money) Format(currency Currency) string {
+	var amount string
 	switch moneyValue := money.ValueOf(); {
 	case math.IsNaN(moneyValue):
-		builder.WriteString("$?")
-	case math.IsInf(moneyValue, 1):
-		builder.WriteString("$∞")
-	case math.IsInf(moneyValue, -1):
-		builder.WriteString("-$∞")
+		amount = "?"
+	case math.IsInf(moneyValue, 0):
+		amount = "∞"
 	default:
-		dollarValue, centValue := math.Modf(moneyValue)
-		dollarString := strconv.FormatFloat(math.Abs(dollarValue), 'f', 0, 64)
-		centString := strings.TrimPrefix(strconv.FormatFloat(math.Abs(centValue), 'f', 2, 64), "0")
+		amount = DefaultLocale.format(moneyValue, currency.Decimals())
+	}
 
-		if moneyValue < 0 {
-			builder.WriteRune('-')
-		}
-		builder.WriteRune('$')
-		for index, dollarRune := range dollarString {
-			if index > 0 && (len(dollarString)-index)%3 == 0 {
-				builder.WriteRune(',')
-			}
-			builder.WriteRune(dollarRune)
-		}
-		builder.WriteString(centString)
+	if DefaultLocale.CurrencyAfter {
+		amount = amount + "\u00a0" + strings.TrimSpace(currency.Symbol())
+	} else {
+		amount = currency.Symbol() + amount
 	}
 
-	return builder.String()
+	if moneyValue := money.ValueOf(); moneyValue < 0 && strings.ContainsAny(amount, "123456789∞") {
+		return DefaultLocale.negative(amount)
+	}
+	return amount
 }
 
 // WriteAnswer implements survey.core.Settable for Money
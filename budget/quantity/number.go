@@ -3,20 +3,13 @@ package quantity
 import (
 	"fmt"
 	"math"
-	"regexp"
-	"strconv"
-	"strings"
-)
-
-var (
-	numberRegexp        = regexp.MustCompile(`^([+\-]?\d+(?:\.\d+)?)$`)
-	numberGroupedRegexp = regexp.MustCompile(`^([+\-]?\d{1,3}(?:,\d{3})*)(?:\.\d+)?$`)
 )
 
 // Number describes a human-friendly mathematical real number
 type Number float64
 
-// NewNumber transforms the given value into a Number, if possible; otherwise this returns an error
+// NewNumber transforms the given value into a Number, if possible; otherwise this returns an error. Strings may be arithmetic expressions,
+// as in "37.5 + 4".
 func NewNumber(value interface{}) (Number, error) {
 	switch numberValue := value.(type) {
 	case Quantity:
@@ -28,22 +21,16 @@ func NewNumber(value interface{}) (Number, error) {
 	case float64:
 		return Number(numberValue), nil
 	case string:
-		if numberRegexp.MatchString(numberValue) {
-			numberValue = numberRegexp.FindStringSubmatch(numberValue)[0]
-			if number, err := strconv.ParseFloat(numberValue, 64); err == nil {
-				return Number(number), err
-			} else {
-				return Number(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Number: %w`, numberValue, err)
-			}
-		} else if numberGroupedRegexp.MatchString(numberValue) {
-			numberValue = strings.ReplaceAll(numberRegexp.FindStringSubmatch(numberValue)[0], ",", "")
-			if number, err := strconv.ParseFloat(numberValue, 64); err == nil {
+		if number, err := DefaultLocale.parse(numberValue, "number"); err == nil {
+			return Number(number), nil
+		} else if IsExpression(numberValue) {
+			if number, _, err := DefaultLocale.evaluate(numberValue, "number"); err == nil {
 				return Number(number), nil
 			} else {
-				return Number(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Number: %w`, numberValue, err)
+				return Number(math.NaN()), fmt.Errorf(`failed to evaluate string %s as budget.Number: %w`, numberValue, err)
 			}
 		} else {
-			return Number(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Number: invalid format`, numberValue)
+			return Number(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Number: %w`, numberValue, err)
 		}
 	case Integer:
 		return Number(numberValue), nil
@@ -52,6 +39,15 @@ func NewNumber(value interface{}) (Number, error) {
 	}
 }
 
+// ParseNumber parses a string written in the locale into a Number, though not arithmetic expressions
+func (locale *Locale) ParseNumber(value string) (Number, error) {
+	number, err := locale.parse(value, "number")
+	if err != nil {
+		return Number(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Number: %w`, value, err)
+	}
+	return Number(number), nil
+}
+
 // MakeNumber transforms the given value into a Number, if possible; otherwise, this panics
 func MakeNumber(value interface{}) Number {
 	if number, err := NewNumber(value); err == nil {
@@ -76,32 +72,20 @@ func (number Number) IsNaN() bool {
 	return math.IsNaN(number.ValueOf())
 }
 
-// String implements fmt.Stringer for Number
+// String implements fmt.Stringer for Number, written in DefaultLocale
 func (number Number) String() string {
-	var builder strings.Builder
-
 	switch numberValue := number.ValueOf(); {
 	case math.IsInf(numberValue, 1):
-		builder.WriteString("∞")
+		return "∞"
 	case math.IsInf(numberValue, -1):
-		builder.WriteString("-∞")
+		return DefaultLocale.negative("∞")
 	case math.IsNaN(numberValue):
-		builder.WriteString("?")
+		return "?"
+	case numberValue < 0:
+		return DefaultLocale.negative(DefaultLocale.format(numberValue, -1))
 	default:
-		integerValue, fractionalValue := math.Modf(numberValue)
-		integerString := strconv.FormatFloat(integerValue, 'f', 0, 64)
-		fractionString := strings.TrimPrefix(strconv.FormatFloat(math.Abs(fractionalValue), 'f', -1, 64), "0")
-
-		for index, integerRune := range integerString {
-			if index > 0 && (len(integerString)-index)%3 == 0 {
-				builder.WriteRune(',')
-			}
-			builder.WriteRune(integerRune)
-		}
-		builder.WriteString(fractionString)
+		return DefaultLocale.format(numberValue, -1)
 	}
-
-	return builder.String()
 }
 
 // WriteAnswer implements survey.core.Settable for Number
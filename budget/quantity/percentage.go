@@ -3,16 +3,9 @@ package quantity
 import (
 	"fmt"
 	"math"
-	"regexp"
-	"strconv"
 	"strings"
 )
 
-var (
-	percentageRegexp        = regexp.MustCompile(`^([+\-]?\d+(?:\.\d+)?)%$`)
-	percentageGroupedRegexp = regexp.MustCompile(`^([+\-]?\d{1,3}(?:,\d{3})*(?:\.\d+)?)%$`)
-)
-
 // Percentage describes a human-friendly percentage
 type Percentage float64
 
@@ -28,23 +21,14 @@ func NewPercentage(value interface{}) (Percentage, error) {
 	case float64:
 		return Percentage(percentageValue / 100), nil
 	case string:
-		if percentageRegexp.MatchString(percentageValue) {
-			percentageValue = strings.TrimSuffix(percentageRegexp.FindStringSubmatch(percentageValue)[0], "%")
-			if percentage, err := strconv.ParseFloat(percentageValue, 64); err == nil {
-				return Percentage(float64(percentage) / 100), nil
-			} else {
-				return Percentage(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Percentage: %w`, percentageValue, err)
-			}
-		} else if percentageGroupedRegexp.MatchString(percentageValue) {
-			percentageValue = strings.ReplaceAll(strings.TrimSuffix(percentageGroupedRegexp.FindStringSubmatch(percentageValue)[0], "%"), ",", "")
-			if percentage, err := strconv.ParseFloat(percentageValue, 64); err == nil {
-				return Percentage(float64(percentage) / 100), nil
-			} else {
-				return Percentage(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Percentage: %w`, percentageValue, err)
-			}
-		} else {
+		if !strings.HasSuffix(strings.TrimSpace(percentageValue), "%") {
 			return Percentage(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Percentage: invalid format`, percentageValue)
 		}
+		if percentage, err := DefaultLocale.parse(strings.TrimSuffix(strings.TrimSpace(percentageValue), "%"), "number"); err == nil {
+			return Percentage(percentage / 100), nil
+		} else {
+			return Percentage(math.NaN()), fmt.Errorf(`failed to parse string %s as budget.Percentage: %w`, percentageValue, err)
+		}
 	default:
 		return Percentage(math.NaN()), fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Percentage: invalid type`, value)
 	}
@@ -74,33 +58,27 @@ func (percentage Percentage) IsNaN() bool {
 	return math.IsNaN(percentage.ValueOf())
 }
 
-// String implements fmt.Stringer for Percentage
+// String implements fmt.Stringer for Percentage, written in DefaultLocale to at most two decimal places
 func (percentage Percentage) String() string {
-	var builder strings.Builder
+	sign := "%"
+	if DefaultLocale.PercentSpace {
+		sign = "\u00a0%"
+	}
 
 	switch percentageValue := percentage.ValueOf(); {
 	case math.IsNaN(percentageValue):
-		builder.WriteString("?%")
+		return "?" + sign
 	case math.IsInf(percentageValue, 1):
-		builder.WriteString("∞%")
+		return "∞" + sign
 	case math.IsInf(percentageValue, -1):
-		builder.WriteString("-∞%")
+		return DefaultLocale.negative("∞" + sign)
 	default:
-		integerValue, fractionalValue := math.Modf(percentageValue * 100)
-		integerString := strconv.FormatFloat(integerValue, 'f', 0, 64)
-		fractionString := strconv.FormatFloat(math.Abs(fractionalValue), 'f', -1, 64)
-
-		for index, integerRune := range integerString {
-			if index > 0 && (len(integerString)-index)%3 == 0 {
-				builder.WriteRune(',')
-			}
-			builder.WriteRune(integerRune)
+		roundedValue := math.Round(percentageValue*10000) / 100
+		if roundedValue < 0 {
+			return DefaultLocale.negative(DefaultLocale.format(roundedValue, -1) + sign)
 		}
-		builder.WriteString(fractionString)
-		builder.WriteRune('%')
+		return DefaultLocale.format(roundedValue, -1) + sign
 	}
-
-	return builder.String()
 }
 
 // WriteAnswer implements survey.core.Settable for Percentage
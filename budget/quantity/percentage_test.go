@@ -0,0 +1,30 @@
+package quantity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPercentageString(t *testing.T) {
+	defer func(locale *Locale) { DefaultLocale = locale }(DefaultLocale)
+
+	for _, test := range []struct {
+		tag        string
+		percentage Percentage
+		want       string
+	}{
+		{"en-US", 0.25, "25%"},
+		{"en-US", 0.123456, "12.35%"},
+		{"en-US", -0.005, "-0.5%"},
+		{"en-US", -0.00001, "0%"},
+		{"en-US", 12.5, "1,250%"},
+		{"en-US", Percentage(math.NaN()), "?%"},
+		{"en-US", Percentage(math.Inf(-1)), "-∞%"},
+		{"de-DE", -0.123456, "-12,35 %"},
+	} {
+		DefaultLocale = MakeLocale(test.tag)
+		if got := test.percentage.String(); got != test.want {
+			t.Errorf("%s: %v: got %q, want %q", test.tag, float64(test.percentage), got, test.want)
+		}
+	}
+}
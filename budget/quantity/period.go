@@ -0,0 +1,169 @@
+package quantity
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PeriodUnit describes a unit of calendar time
+type PeriodUnit string
+
+// Units of calendar time, from shortest to longest
+const (
+	HourUnit      PeriodUnit = "hour"
+	DayUnit       PeriodUnit = "day"
+	WeekUnit      PeriodUnit = "week"
+	SemimonthUnit PeriodUnit = "semimonth" // Half of a month, as when paid on the 1st and 15th
+	MonthUnit     PeriodUnit = "month"
+	QuarterUnit   PeriodUnit = "quarter"
+	YearUnit      PeriodUnit = "year"
+)
+
+// unitsPerYear is the number of each unit of calendar time in a year. A year is taken to be 52 weeks of 7 days, or 364 days, as is usual
+// for budgeting, so that days, weeks and months convert consistently: $100/day is $700/week, and a month is 52/12 weeks as in wages.
+// Hours are working time rather than calendar time, so they have no fixed number in a year; see Rate.Worked.
+var unitsPerYear = map[PeriodUnit]int64{
+	DayUnit:       7 * 52,
+	WeekUnit:      52,
+	SemimonthUnit: 24,
+	MonthUnit:     12,
+	QuarterUnit:   4,
+	YearUnit:      1,
+}
+
+// periodNames maps the names, abbreviations and adverbs of units to periods
+var periodNames = map[string]Period{
+	"h": {1, HourUnit}, "hr": {1, HourUnit}, "hour": {1, HourUnit}, "hourly": {1, HourUnit},
+	"d": {1, DayUnit}, "day": {1, DayUnit}, "daily": {1, DayUnit},
+	"w": {1, WeekUnit}, "wk": {1, WeekUnit}, "week": {1, WeekUnit}, "weekly": {1, WeekUnit},
+	"fortnight": {2, WeekUnit}, "fortnightly": {2, WeekUnit}, "biweekly": {2, WeekUnit},
+	"semimonth": {1, SemimonthUnit}, "semimonthly": {1, SemimonthUnit},
+	"mo": {1, MonthUnit}, "mon": {1, MonthUnit}, "month": {1, MonthUnit}, "monthly": {1, MonthUnit},
+	"q": {1, QuarterUnit}, "qtr": {1, QuarterUnit}, "quarter": {1, QuarterUnit}, "quarterly": {1, QuarterUnit},
+	"y": {1, YearUnit}, "yr": {1, YearUnit}, "year": {1, YearUnit}, "annum": {1, YearUnit}, "annual": {1, YearUnit}, "yearly": {1, YearUnit}, "annually": {1, YearUnit},
+}
+
+var periodRegexp = regexp.MustCompile(`^(?:(\d+)\s*)?([a-z]+?)s?$`)
+
+// Period describes a span of calendar time that amounts recur over, such as a month or two weeks
+type Period struct {
+	Count int64      // Number of units in the period
+	Unit  PeriodUnit // Unit of the period
+}
+
+// Common periods
+var (
+	Hour      = Period{1, HourUnit}
+	Day       = Period{1, DayUnit}
+	Week      = Period{1, WeekUnit}
+	Semimonth = Period{1, SemimonthUnit}
+	Month     = Period{1, MonthUnit}
+	Quarter   = Period{1, QuarterUnit}
+	Year      = Period{1, YearUnit}
+)
+
+// NewPeriod transforms the given value into a Period, if possible; otherwise this returns an error. Periods may be written as units,
+// as in "month", "hr" or "weekly", or as a count of units, as in "2 weeks". If no period is given, the period is a month.
+func NewPeriod(value interface{}) (Period, error) {
+	switch periodValue := value.(type) {
+	case Period:
+		if _, known := unitsPerYear[periodValue.Unit]; (!known && periodValue.Unit != HourUnit) || periodValue.Count < 1 {
+			return Period{}, fmt.Errorf(`failed to parse %v as budget.Period: invalid period`, periodValue)
+		}
+		return periodValue, nil
+	case PeriodUnit:
+		return NewPeriod(Period{1, periodValue})
+	case nil:
+		return Month, nil
+	case string:
+		normalizedValue := strings.ToLower(strings.TrimSpace(periodValue))
+		if period, ok := periodNames[normalizedValue]; ok {
+			return period, nil
+		}
+		if match := periodRegexp.FindStringSubmatch(normalizedValue); match != nil {
+			if period, ok := periodNames[match[2]]; ok {
+				if match[1] != "" {
+					count, err := strconv.ParseInt(match[1], 10, 64)
+					if err != nil || count < 1 {
+						return Period{}, fmt.Errorf(`failed to parse string %s as budget.Period: invalid count`, periodValue)
+					}
+					period.Count *= count
+				}
+				return period, nil
+			}
+		}
+		return Period{}, fmt.Errorf(`failed to parse string %s as budget.Period: unknown period`, periodValue)
+	default:
+		return Period{}, fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Period: invalid type`, value)
+	}
+}
+
+// NewCalendarPeriod transforms the given value into a Period of calendar time, as in NewPeriod, but returns an error for hours, which
+// are working time and cannot be converted into other periods
+func NewCalendarPeriod(value interface{}) (Period, error) {
+	period, err := NewPeriod(value)
+	if err == nil && !period.IsCalendar() {
+		return Period{}, fmt.Errorf(`failed to parse %v as budget.Period: hours of work are not calendar time; use days or weeks instead`, value)
+	}
+	return period, err
+}
+
+// MakePeriod transforms the given value into a Period, if possible; otherwise, this panics
+func MakePeriod(value interface{}) Period {
+	if period, err := NewPeriod(value); err == nil {
+		return period
+	} else {
+		panic(err)
+	}
+}
+
+// IsCalendar reports whether the period is calendar time, which all periods are except hours of work
+func (period Period) IsCalendar() bool {
+	_, calendar := unitsPerYear[period.Unit]
+	return calendar
+}
+
+// perYear returns the exact number of periods in a year
+func (period Period) perYear() *big.Rat {
+	return big.NewRat(unitsPerYear[period.Unit], period.Count)
+}
+
+// In returns the exact number of periods within another period, such as 52/12 weeks in a month. Hours of work are not within calendar
+// periods, so if either period is in hours, this returns nil.
+func (period Period) In(other Period) *big.Rat {
+	if !period.IsCalendar() || !other.IsCalendar() {
+		return nil
+	}
+	return new(big.Rat).Quo(period.perYear(), other.perYear())
+}
+
+// String implements fmt.Stringer for Period
+func (period Period) String() string {
+	if period.Count == 1 {
+		return string(period.Unit)
+	}
+	return fmt.Sprintf("%d %ss", period.Count, period.Unit)
+}
+
+// MarshalText implements encoding.TextMarshaler for Period
+func (period Period) MarshalText() ([]byte, error) {
+	return []byte(period.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Period
+func (period *Period) UnmarshalText(text []byte) error {
+	return period.WriteAnswer("", string(text))
+}
+
+// WriteAnswer implements survey.core.Settable for Period
+func (period *Period) WriteAnswer(field string, value interface{}) error {
+	if periodValue, err := NewPeriod(value); err == nil {
+		*period = periodValue
+	} else {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package quantity
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestRateIn(t *testing.T) {
+	for _, test := range []struct {
+		rate   string
+		period Period
+		want   float64
+	}{
+		{"$100/day", Week, 700},
+		{"$700/week", Day, 100},
+		{"$100/day", Year, 36400},
+		{"$1,000/week", Month, 52000.0 / 12},
+		{"$1,000/2 weeks", Week, 500},
+		{"$1,000/fortnight", Year, 26000},
+		{"$1,200/quarter", Month, 400},
+		{"$500/semimonth", Month, 1000},
+		{"$60,000/year", Month, 5000},
+		{"$60,000/year", Week, 60000.0 / 52},
+		{"$2,000", Quarter, 6000},
+	} {
+		rate, err := NewRate(test.rate)
+		if err != nil {
+			t.Errorf("%s: %s", test.rate, err)
+			continue
+		}
+		if got := rate.In(test.period).Money.ValueOf(); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s in %s: got %v, want %v", test.rate, test.period, got, test.want)
+		}
+	}
+
+	// Converting there and back is exact
+	for _, period := range []Period{Day, Week, Semimonth, Month, Quarter, Year, MakePeriod("2 weeks")} {
+		if ratio := period.In(Month); ratio.Cmp(new(big.Rat).Inv(Month.In(period))) != 0 {
+			t.Errorf("%s in a month is not the inverse of a month in %s", period, period)
+		}
+	}
+}
+
+func TestHourlyRate(t *testing.T) {
+	rate := MakeRate("$25/hour")
+	if monthly := rate.Monthly(); !monthly.IsNaN() {
+		t.Errorf("$25/hour was converted to %s per month without the hours worked", monthly)
+	}
+	if weekly := rate.Worked(40); weekly.Per != Week || weekly.Money != 1000 {
+		t.Errorf("$25/hour for 40 hours a week: got %s, want $1,000.00/week", weekly)
+	}
+	if monthly := rate.Worked(40).Monthly().ValueOf(); math.Abs(monthly-52000.0/12) > 1e-9 {
+		t.Errorf("$25/hour for 40 hours a week: got %v per month, want %v", monthly, 52000.0/12)
+	}
+	if weekly := MakeRate("$50/2 hours").Worked(40); weekly.Money != 1000 {
+		t.Errorf("$50/2 hours for 40 hours a week: got %s, want $1,000.00/week", weekly)
+	}
+	if weekly := MakeRate("$700/week").Worked(40); weekly.Money != 700 {
+		t.Errorf("a weekly rate changed when worked: got %s", weekly)
+	}
+
+	if _, err := NewCalendarPeriod("hour"); err == nil {
+		t.Error("an hour was accepted as calendar time")
+	}
+	if period, err := NewCalendarPeriod("biweekly"); err != nil || period != MakePeriod("2 weeks") {
+		t.Errorf("biweekly: got %s, %v", period, err)
+	}
+}
@@ -0,0 +1,145 @@
+package quantity
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Rate describes an amount of money per period of time, such as $25/hour or $1,200/quarter. Rates per hour are per hour of work, and
+// only convert into calendar periods given the hours worked per week.
+type Rate struct {
+	Money    Money    // Amount per period
+	Currency Currency // Currency of the amount; if empty, the currency is unspecified
+	Per      Period   // Period the amount recurs over
+}
+
+// NewRate transforms the given value into a Rate, if possible; otherwise this returns an error. Rates are written as an amount of money
+// followed by a period, separated by "/", "per" or "a", as in "$25/hour", "€1.200 per quarter" or "$50 a week", or by a period adverb,
+// as in "$500 monthly". Amounts without a period are per month, and may be arithmetic expressions, as in "$1,200/12".
+func NewRate(value interface{}) (Rate, error) {
+	switch rateValue := value.(type) {
+	case Rate:
+		return rateValue, nil
+	case nil:
+		return Rate{Per: Month}, nil
+	case Money:
+		return Rate{Money: rateValue, Per: Month}, nil
+	case string:
+		amount, period := splitRate(rateValue)
+		money, currency, err := ParseMoney(amount)
+		if err != nil {
+			return Rate{Money: Money(math.NaN()), Per: Month}, fmt.Errorf(`failed to parse string %s as budget.Rate: %w`, rateValue, err)
+		}
+		per, err := NewPeriod(period)
+		if err != nil {
+			return Rate{Money: Money(math.NaN()), Per: Month}, fmt.Errorf(`failed to parse string %s as budget.Rate: %w`, rateValue, err)
+		}
+		return Rate{Money: money, Currency: currency, Per: per}, nil
+	default:
+		return Rate{Money: Money(math.NaN()), Per: Month}, fmt.Errorf(`failed to parse %[1]T %[1]v as budget.Rate: invalid type`, value)
+	}
+}
+
+// MakeRate transforms the given value into a Rate, if possible; otherwise, this panics
+func MakeRate(value interface{}) Rate {
+	if rate, err := NewRate(value); err == nil {
+		return rate
+	} else {
+		panic(err)
+	}
+}
+
+// splitRate splits a written rate into its amount and period. If no period was written, the period is nil.
+func splitRate(value string) (string, interface{}) {
+	fields := strings.Fields(value)
+	for index := len(fields) - 2; index > 0; index-- {
+		switch strings.ToLower(fields[index]) {
+		case "per", "a", "an", "every":
+			return strings.Join(fields[:index], " "), strings.Join(fields[index+1:], " ")
+		}
+	}
+	if index := strings.LastIndex(value, "/"); index >= 0 {
+		if _, err := NewPeriod(value[index+1:]); err == nil {
+			return value[:index], value[index+1:]
+		}
+	}
+	if len(fields) > 1 {
+		if _, err := NewPeriod(fields[len(fields)-1]); err == nil {
+			return strings.Join(fields[:len(fields)-1], " "), fields[len(fields)-1]
+		}
+	}
+
+	// Without a period, any division is part of an arithmetic expression, as in "1200/12"
+	return value, nil
+}
+
+// In converts the rate exactly to another period, such as $1,200/quarter to $400/month. Rates per hour of work cannot be converted into
+// calendar periods, so their amount is NaN; convert them with Worked first.
+func (rate Rate) In(period Period) Rate {
+	ratio := rate.Per.In(period)
+	if ratio == nil {
+		return Rate{Money: Money(math.NaN()), Currency: rate.Currency, Per: period}
+	}
+	numerator, _ := new(big.Float).SetInt(ratio.Num()).Float64()
+	denominator, _ := new(big.Float).SetInt(ratio.Denom()).Float64()
+	return Rate{
+		Money:    Money(rate.Money.ValueOf() * numerator / denominator),
+		Currency: rate.Currency,
+		Per:      period,
+	}
+}
+
+// Worked converts a rate per hours of work into a rate per week, given the hours worked per week, such as $25/hour for 40 hours a week
+// into $1,000/week. Rates per calendar period are returned as they are.
+func (rate Rate) Worked(hoursPerWeek Number) Rate {
+	if rate.Per.IsCalendar() {
+		return rate
+	}
+	return Rate{
+		Money:    Money(rate.Money.ValueOf() * hoursPerWeek.ValueOf() / float64(rate.Per.Count)),
+		Currency: rate.Currency,
+		Per:      Week,
+	}
+}
+
+// Monthly returns the amount of money per month
+func (rate Rate) Monthly() Money {
+	return rate.In(Month).Money
+}
+
+// ValueOf implements Quantity for Rate, as the amount of money per period
+func (rate Rate) ValueOf() float64 {
+	return rate.Money.ValueOf()
+}
+
+// IsInf is a wrapper of math.IsInf
+func (rate Rate) IsInf(sign int) bool {
+	return rate.Money.IsInf(sign)
+}
+
+// IsNaN is a wrapper of math.IsNaN
+func (rate Rate) IsNaN() bool {
+	return rate.Money.IsNaN()
+}
+
+// Format writes the rate in the given currency, unless the rate specifies its own currency
+func (rate Rate) Format(currency Currency) string {
+	return rate.Money.Format(rate.Currency.Or(currency)) + "/" + rate.Per.String()
+}
+
+// String implements fmt.Stringer for Rate
+func (rate Rate) String() string {
+	return rate.Format(DefaultCurrency)
+}
+
+// WriteAnswer implements survey.core.Settable for Rate
+func (rate *Rate) WriteAnswer(field string, value interface{}) error {
+	if rateValue, err := NewRate(value); err == nil {
+		*rate = rateValue
+	} else {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package budget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+)
+
+// Rule describes how to match a transaction to an expense or income source.
+// A transaction matches a rule when it matches every criterion the rule specifies.
+type Rule struct {
+	Category string   `json:"category"`          // Name of the expense or income source to link
+	Payee    string   `json:"payee,omitempty"`   // Regular expression the payee must match
+	Memo     string   `json:"memo,omitempty"`    // Regular expression the memo must match
+	Minimum  *float64 `json:"minimum,omitempty"` // Smallest amount, regardless of sign, inclusively
+	Maximum  *float64 `json:"maximum,omitempty"` // Largest amount, regardless of sign, inclusively
+
+	payeeRegexp *regexp.Regexp
+	memoRegexp  *regexp.Regexp
+}
+
+// Matches reports whether the transaction matches the rule
+func (rule *Rule) Matches(transaction *Transaction) bool {
+	amount := math.Abs(transaction.Amount.ValueOf())
+	switch {
+	case rule.payeeRegexp != nil && !rule.payeeRegexp.MatchString(transaction.Payee):
+		return false
+	case rule.memoRegexp != nil && !rule.memoRegexp.MatchString(transaction.Memo):
+		return false
+	case rule.Minimum != nil && amount < *rule.Minimum:
+		return false
+	case rule.Maximum != nil && amount > *rule.Maximum:
+		return false
+	default:
+		return true
+	}
+}
+
+// compile compiles the regular expressions of the rule
+func (rule *Rule) compile() error {
+	if rule.Category == "" {
+		return errors.New("rule is missing a category")
+	}
+	if rule.Payee != "" {
+		payeeRegexp, err := regexp.Compile(rule.Payee)
+		if err != nil {
+			return fmt.Errorf("invalid payee pattern for %q: %w", rule.Category, err)
+		}
+		rule.payeeRegexp = payeeRegexp
+	}
+	if rule.Memo != "" {
+		memoRegexp, err := regexp.Compile(rule.Memo)
+		if err != nil {
+			return fmt.Errorf("invalid memo pattern for %q: %w", rule.Category, err)
+		}
+		rule.memoRegexp = memoRegexp
+	}
+	return nil
+}
+
+// RuleList is an ordered list of rules; the first matching rule wins
+type RuleList []*Rule
+
+// LoadRules loads the categorisation rules of the named budget from disk. If no rules have been written yet, an empty list is returned.
+func LoadRules(name string) (RuleList, error) {
+	fileReader, err := os.Open(fmt.Sprintf("%s.rules", name))
+	if errors.Is(err, os.ErrNotExist) {
+		return RuleList{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fileReader.Close()
+
+	var rules RuleList
+	decoder := json.NewDecoder(fileReader)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if err := rule.compile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+// Categorize returns the category of the first rule matching the transaction, and whether any rule matched
+func (rules RuleList) Categorize(transaction *Transaction) (string, bool) {
+	for _, rule := range rules {
+		if rule.Matches(transaction) {
+			return rule.Category, true
+		}
+	}
+	return "", false
+}
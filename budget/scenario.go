@@ -0,0 +1,94 @@
+package budget
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Scenario describes a what-if variation of a budget, as a set of overrides on top of it
+type Scenario struct {
+	Description      string               `json:"description,omitempty"`
+	Income           IncomeList           `json:"income,omitempty"`             // Income sources added to the budget, or replacing those of the same name
+	Expenses         ExpenseList          `json:"expenses,omitempty"`           // Expenses added to the budget, or replacing those of the same name
+	Remove           []string             `json:"remove,omitempty"`             // Names of income sources and expenses removed from the budget
+	NetPayPercentage *quantity.Percentage `json:"net_pay_percentage,omitempty"` // Share of gross pay assumed to be received; if empty, as configured
+	Savings          *quantity.Money      `json:"savings,omitempty"`            // Savings balance; if empty, that of the budget
+}
+
+// MakeScenario makes an empty scenario
+func MakeScenario(description string) *Scenario {
+	return &Scenario{
+		Description: description,
+		Income:      make(IncomeList),
+		Expenses:    make(ExpenseList),
+	}
+}
+
+// ScenarioList is a named list of scenarios
+type ScenarioList map[string]*Scenario
+
+// SortedNames sorts the names of scenarios lexographically
+func (list ScenarioList) SortedNames() []string {
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Removes reports whether the scenario removes the income source or expense of the given name
+func (scenario *Scenario) Removes(name string) bool {
+	for _, removed := range scenario.Remove {
+		if removed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Scenario returns a copy of the budget with the overrides of the named scenario. Income and expenses removed by the scenario are
+// left out first, then those of the scenario are added, replacing any of the same name. If the scenario assumes a net pay percentage,
+// income sources are copied to assume it, leaving NetPayPercentage and those of the budget as they were. The copy has no scenarios
+// of its own and should not be saved.
+func (budget *Budget) Scenario(name string) (*Budget, error) {
+	scenario, ok := budget.Scenarios[name]
+	if !ok {
+		return nil, fmt.Errorf(`No scenario named "%s"`, name)
+	}
+
+	scenarioBudget := Make(budget.name)
+	scenarioBudget.Currency = budget.Currency
+	scenarioBudget.Savings = budget.Savings
+	scenarioBudget.Events = budget.Events
+	for incomeName, income := range budget.Income {
+		if !scenario.Removes(incomeName) {
+			scenarioBudget.Income[incomeName] = income
+		}
+	}
+	for expenseName, expense := range budget.Expenses {
+		if !scenario.Removes(expenseName) {
+			scenarioBudget.Expenses[expenseName] = expense
+		}
+	}
+	for incomeName, income := range scenario.Income {
+		scenarioBudget.Income[incomeName] = income
+	}
+	if scenario.NetPayPercentage != nil {
+		netPayPercentage := scenario.NetPayPercentage.ValueOf()
+		for incomeName, income := range scenarioBudget.Income {
+			assumedIncome := copyIncome(income)
+			assumedIncome.Attributes().netPay = &netPayPercentage
+			scenarioBudget.Income[incomeName] = assumedIncome
+		}
+	}
+	for expenseName, expense := range scenario.Expenses {
+		scenarioBudget.Expenses[expenseName] = expense
+	}
+	if scenario.Savings != nil {
+		scenarioBudget.Savings = *scenario.Savings
+	}
+	return scenarioBudget, nil
+}
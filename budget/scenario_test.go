@@ -0,0 +1,43 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestScenarioAssumesNetPay(t *testing.T) {
+	defer func(netPayPercentage, minimumOvertimeHours float64) {
+		NetPayPercentage, MinimumOvertimeHours = netPayPercentage, minimumOvertimeHours
+	}(NetPayPercentage, MinimumOvertimeHours)
+	NetPayPercentage, MinimumOvertimeHours = 0.75, 40
+
+	based := Make("test")
+	based.Income["job"] = &Salary{Salary: 48000}
+	netPay := quantity.Percentage(0.5)
+	based.Scenarios = ScenarioList{
+		"taxed": {Income: IncomeList{"gig": &Wages{Rate: 20, Hours: 10}}, NetPayPercentage: &netPay},
+	}
+
+	scenarioBudget, err := based.Scenario("taxed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := scenarioBudget.Income["job"].MonthlyIncome(); got != 2000 {
+		t.Errorf("scenario salary: got %v, want 2000", got)
+	}
+	if got, want := scenarioBudget.Income["gig"].MonthlyIncome(), (quantity.Rate{Money: 100, Per: quantity.Week}.Monthly()); got != want {
+		t.Errorf("scenario wages: got %v, want %v", got, want)
+	}
+
+	// The budget, the income sources of the scenario and the configured net pay percentage are left as they were
+	if NetPayPercentage != 0.75 {
+		t.Errorf("the net pay percentage was changed to %v", NetPayPercentage)
+	}
+	if got := based.Income["job"].MonthlyIncome(); got != 3000 {
+		t.Errorf("base salary: got %v, want 3000", got)
+	}
+	if got, want := based.Scenarios["taxed"].Income["gig"].MonthlyIncome(), (quantity.Rate{Money: 150, Per: quantity.Week}.Monthly()); got != want {
+		t.Errorf("wages of the scenario itself: got %v, want %v", got, want)
+	}
+}
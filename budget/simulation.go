@@ -0,0 +1,187 @@
+package budget
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// simulationChunk is the number of trials of a simulation run together from the same seed, so results do not depend on how many
+// goroutines run them
+const simulationChunk = 1000
+
+// Distribution describes how the monthly amount of an income source or expense varies from month to month, in its currency.
+// It is either a triangular distribution between a minimum and maximum, peaking at the likeliest amount, or a normal
+// distribution with a mean and standard deviation. Amounts drawn are never negative.
+type Distribution struct {
+	Min    quantity.Money `json:"min,omitempty"`    // Least amount of a triangular distribution
+	Likely quantity.Money `json:"likely,omitempty"` // Likeliest amount of a triangular distribution
+	Max    quantity.Money `json:"max,omitempty"`    // Greatest amount of a triangular distribution
+	Mean   quantity.Money `json:"mean,omitempty"`   // Mean amount of a normal distribution
+	StdDev quantity.Money `json:"stddev,omitempty"` // Standard deviation of a normal distribution; if empty, the distribution is triangular
+}
+
+// NewTriangularDistribution makes a triangular distribution, if the amounts are in order
+func NewTriangularDistribution(min, likely, max quantity.Money) (*Distribution, error) {
+	if min < 0 || min > likely || likely > max || min == max {
+		return nil, errors.New("the minimum, likeliest and maximum amounts must be in order, and the minimum less than the maximum")
+	}
+	return &Distribution{Min: min, Likely: likely, Max: max}, nil
+}
+
+// NewNormalDistribution makes a normal distribution, if the mean is not negative and the standard deviation is positive
+func NewNormalDistribution(mean, stdDev quantity.Money) (*Distribution, error) {
+	if mean < 0 || stdDev <= 0 {
+		return nil, errors.New("the mean must not be negative, and the standard deviation must be positive")
+	}
+	return &Distribution{Mean: mean, StdDev: stdDev}, nil
+}
+
+// Normal reports whether the distribution is normal, rather than triangular
+func (distribution *Distribution) Normal() bool {
+	return distribution.StdDev > 0
+}
+
+// Expected returns the mean amount of the distribution
+func (distribution *Distribution) Expected() quantity.Money {
+	if distribution.Normal() {
+		return distribution.Mean
+	}
+	return (distribution.Min + distribution.Likely + distribution.Max) / 3
+}
+
+// Sample draws an amount from the distribution
+func (distribution *Distribution) Sample(random *rand.Rand) quantity.Money {
+	if distribution.Normal() {
+		return quantity.Money(math.Max(0, distribution.Mean.ValueOf()+distribution.StdDev.ValueOf()*random.NormFloat64()))
+	}
+	// Inverse of the cumulative distribution function of a triangular distribution
+	min, likely, max := distribution.Min.ValueOf(), distribution.Likely.ValueOf(), distribution.Max.ValueOf()
+	u := random.Float64()
+	if u < (likely-min)/(max-min) {
+		return quantity.Money(min + math.Sqrt(u*(max-min)*(likely-min)))
+	}
+	return quantity.Money(max - math.Sqrt((1-u)*(max-min)*(max-likely)))
+}
+
+// converted returns the distribution with its amounts converted from one currency to another
+func (distribution Distribution) converted(from, to quantity.Currency) Distribution {
+	for _, money := range []*quantity.Money{&distribution.Min, &distribution.Likely, &distribution.Max, &distribution.Mean, &distribution.StdDev} {
+		*money = Convert(*money, from, to, time.Now())
+	}
+	return distribution
+}
+
+// Simulation describes the monthly surpluses of the trials of a simulation of a budget, in the currency of the budget
+type Simulation struct {
+	Seed      int64            // Seed the trials were drawn from
+	Surpluses []quantity.Money // Income less expenses of each trial, sorted from least to greatest
+}
+
+// Percentile returns the surplus that the given share of trials fall at or below, such as 0.05 for the 5th percentile
+func (simulation Simulation) Percentile(share float64) quantity.Money {
+	if len(simulation.Surpluses) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(share*float64(len(simulation.Surpluses)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return simulation.Surpluses[index]
+}
+
+// Mean returns the mean surplus of the trials
+func (simulation Simulation) Mean() quantity.Money {
+	if len(simulation.Surpluses) == 0 {
+		return 0
+	}
+	var sum quantity.Money
+	for _, surplus := range simulation.Surpluses {
+		sum += surplus
+	}
+	return sum / quantity.Money(len(simulation.Surpluses))
+}
+
+// DeficitProbability returns the share of trials in which expenses exceed income
+func (simulation Simulation) DeficitProbability() quantity.Percentage {
+	if len(simulation.Surpluses) == 0 {
+		return 0
+	}
+	deficits := sort.Search(len(simulation.Surpluses), func(index int) bool {
+		return simulation.Surpluses[index] >= 0
+	})
+	return quantity.Percentage(float64(deficits) / float64(len(simulation.Surpluses)))
+}
+
+// Simulate runs trials of a month of the budget, drawing the amounts of income sources and expenses that vary from their
+// distributions, and keeping the others fixed. Trials are run in chunks by the given number of goroutines, each chunk drawing
+// from its own seed derived from the given one, so the same seed always gives the same results.
+func (budget *Budget) Simulate(trials int, seed int64, workers int) Simulation {
+	currency := budget.ReportingCurrency()
+
+	// Sum the fixed amounts, and convert the distributions of the varying ones, before running trials. Both are done in order of name,
+	// rather than in the random order of maps, so each distribution draws the same numbers from the same seed.
+	var fixed quantity.Money
+	var incomeDistributions, expenseDistributions []Distribution
+	for _, name := range budget.Income.SortedNames() {
+		income := budget.Income[name]
+		if varies := income.Attributes().Varies; varies != nil {
+			incomeDistributions = append(incomeDistributions, varies.converted(income.Attributes().Currency, currency))
+		} else {
+			fixed += budget.Income.Converted(name, currency)
+		}
+	}
+	for _, name := range budget.Expenses.SortedNames() {
+		expense := budget.Expenses[name]
+		if expense.Varies != nil {
+			expenseDistributions = append(expenseDistributions, expense.Varies.converted(expense.Currency, currency))
+		} else {
+			fixed -= budget.Expenses.Converted(name, currency)
+		}
+	}
+
+	surpluses := make([]quantity.Money, trials)
+	chunks := make(chan int)
+	var waitGroup sync.WaitGroup
+	if workers < 1 {
+		workers = 1
+	}
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for chunk := range chunks {
+				random := rand.New(rand.NewSource(seed + int64(chunk)))
+				end := (chunk + 1) * simulationChunk
+				if end > trials {
+					end = trials
+				}
+				for trial := chunk * simulationChunk; trial < end; trial++ {
+					surplus := fixed
+					for index := range incomeDistributions {
+						surplus += incomeDistributions[index].Sample(random)
+					}
+					for index := range expenseDistributions {
+						surplus -= expenseDistributions[index].Sample(random)
+					}
+					surpluses[trial] = surplus
+				}
+			}
+		}()
+	}
+	for chunk := 0; chunk*simulationChunk < trials; chunk++ {
+		chunks <- chunk
+	}
+	close(chunks)
+	waitGroup.Wait()
+
+	sort.Slice(surpluses, func(i, j int) bool {
+		return surpluses[i] < surpluses[j]
+	})
+	return Simulation{Seed: seed, Surpluses: surpluses}
+}
@@ -0,0 +1,40 @@
+package budget
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestSimulateIsReproducible(t *testing.T) {
+	simulated := Make("test")
+	for name, mean := range map[string]quantity.Money{"tips": 400, "gigs": 900, "resale": 250} {
+		varies, _ := NewNormalDistribution(mean, mean/4)
+		simulated.Income[name] = &Supplemental{Money: mean, IncomeAttributes: IncomeAttributes{Varies: varies}}
+	}
+	simulated.Income["salary"] = &Supplemental{Money: 3000}
+	for name, likely := range map[string]quantity.Money{"food": 400, "fuel": 150, "power": 90, "water": 40, "fun": 200} {
+		varies, _ := NewTriangularDistribution(likely/2, likely, likely*2)
+		simulated.Expenses[name] = &Expense{Amount: likely, Varies: varies}
+	}
+	simulated.Expenses["rent"] = &Expense{Amount: 1200}
+
+	// Trials span several chunks, so that workers take chunks in different orders
+	const trials = 5*simulationChunk + 17
+	want := simulated.Simulate(trials, 42, 1)
+	if len(want.Surpluses) != trials {
+		t.Fatalf("got %d trials, want %d", len(want.Surpluses), trials)
+	}
+	for run := 0; run < 10; run++ {
+		for _, workers := range []int{1, 3, 8} {
+			if got := simulated.Simulate(trials, 42, workers); !reflect.DeepEqual(got, want) {
+				t.Fatalf("run %d with %d workers: the same seed gave different results", run+1, workers)
+			}
+		}
+	}
+
+	if other := simulated.Simulate(trials, 43, 1); reflect.DeepEqual(other.Surpluses, want.Surpluses) {
+		t.Error("different seeds gave the same results")
+	}
+}
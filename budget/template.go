@@ -0,0 +1,198 @@
+package budget
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// templateExtension is the extension of template files
+const templateExtension = ".template"
+
+// builtinTemplates are the templates shipped with budgetbuddy
+//
+//go:embed templates/*.template
+var builtinTemplates embed.FS
+
+// Template describes the income sources and expenses a budget starts with. Amounts left blank, as zero, are filled in when
+// a budget is created from the template.
+type Template struct {
+	Description string            `json:"description,omitempty"`
+	Currency    quantity.Currency `json:"currency,omitempty"` // Currency of budgets created from the template; if empty, quantity.DefaultCurrency
+	Income      IncomeList        `json:"income"`
+	Expenses    ExpenseList       `json:"expenses"`
+	builtin     bool
+}
+
+// MakeTemplate makes a template of the income sources and expenses of a budget. Savings, one-time events, scenarios, and when
+// income sources and expenses start and end are left out, since they are particular to the budget. If blank, the amounts of
+// income sources and expenses, and how they vary, are also left out, to be filled in when a budget is created from the template.
+func MakeTemplate(templateBudget *Budget, description string, blank bool) *Template {
+	template := &Template{
+		Description: description,
+		Currency:    templateBudget.Currency,
+		Income:      make(IncomeList),
+		Expenses:    make(ExpenseList),
+	}
+	for name, income := range templateBudget.Income {
+		templateIncome := copyIncome(income)
+		if blank {
+			templateIncome = reflect.New(reflect.Indirect(reflect.ValueOf(income)).Type()).Interface().(Income)
+			if commissions, ok := templateIncome.(*Commissions); ok {
+				commissions.Volume = []quantity.Money{}
+			}
+			*templateIncome.Attributes() = *income.Attributes()
+			templateIncome.Attributes().Varies = nil
+		}
+		templateIncome.Attributes().PaidOn = nil
+		templateIncome.Attributes().Schedule = Schedule{}
+		template.Income[name] = templateIncome
+	}
+	for name, expense := range templateBudget.Expenses {
+		templateExpense := *expense
+		if blank {
+			templateExpense.Amount = 0
+			templateExpense.Varies = nil
+		}
+		templateExpense.Schedule = Schedule{}
+		template.Expenses[name] = &templateExpense
+	}
+	return template
+}
+
+// IsBlank reports whether any of the amounts of an income source are left blank
+func IsBlank(income Income) bool {
+	switch income := income.(type) {
+	case *Wages:
+		return income.Rate == 0 || income.Hours == 0
+	case *Salary:
+		return income.Salary == 0
+	case *Sales:
+		return income.Rate == 0 || income.Items == 0
+	case *Commissions:
+		return income.Rate == 0 || len(income.Volume) == 0
+	case *Supplemental:
+		return income.Money == 0
+	default:
+		return false
+	}
+}
+
+// IncomeType returns the type of an income source, such as Wages or Salary
+func IncomeType(income Income) string {
+	return reflect.Indirect(reflect.ValueOf(income)).Type().Name()
+}
+
+// Builtin reports whether the template is shipped with budgetbuddy
+func (template *Template) Builtin() bool {
+	return template.builtin
+}
+
+// Budget makes a named budget from the template
+func (template *Template) Budget(name string) *Budget {
+	templateBudget := Make(name)
+	templateBudget.Currency = template.Currency
+	for incomeName, income := range template.Income {
+		templateBudget.Income[incomeName] = copyIncome(income)
+	}
+	for expenseName, expense := range template.Expenses {
+		templateExpense := *expense
+		templateBudget.Expenses[expenseName] = &templateExpense
+	}
+	return templateBudget
+}
+
+// templatePath returns the path of the file of a template saved in TemplatesDir
+func templatePath(name string) string {
+	return filepath.Join(TemplatesDir, name+templateExtension)
+}
+
+// decodeTemplate decodes a template from a file
+func decodeTemplate(file fs.File) (*Template, error) {
+	defer file.Close()
+	template := &Template{Income: make(IncomeList), Expenses: make(ExpenseList)}
+	if err := json.NewDecoder(file).Decode(template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// LoadTemplate loads a template saved in TemplatesDir, or else one shipped with budgetbuddy
+func LoadTemplate(name string) (*Template, error) {
+	if file, err := os.Open(templatePath(name)); err == nil {
+		return decodeTemplate(file)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	file, err := builtinTemplates.Open("templates/" + name + templateExtension)
+	if err != nil {
+		return nil, fmt.Errorf("no template named %q", name)
+	}
+	template, err := decodeTemplate(file)
+	if err != nil {
+		return nil, err
+	}
+	template.builtin = true
+	return template, nil
+}
+
+// SaveTemplate saves a named template in TemplatesDir, creating it if needed
+func SaveTemplate(name string, template *Template) error {
+	if err := os.MkdirAll(TemplatesDir, 0755); err != nil {
+		return err
+	}
+	fileWriter, err := os.Create(templatePath(name))
+	if err != nil {
+		return err
+	}
+	defer fileWriter.Close()
+
+	encoder := json.NewEncoder(fileWriter)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(template)
+}
+
+// DeleteTemplate deletes a named template saved in TemplatesDir. Templates shipped with budgetbuddy cannot be deleted.
+func DeleteTemplate(name string) error {
+	err := os.Remove(templatePath(name))
+	if errors.Is(err, os.ErrNotExist) {
+		if _, builtinErr := fs.Stat(builtinTemplates, "templates/"+name+templateExtension); builtinErr == nil {
+			return fmt.Errorf("template %q is shipped with budgetbuddy", name)
+		}
+		return fmt.Errorf("no template named %q in %s", name, TemplatesDir)
+	}
+	return err
+}
+
+// TemplateNames returns the names of the templates saved in TemplatesDir and those shipped with budgetbuddy, sorted lexographically.
+// Saved templates hide shipped templates of the same name.
+func TemplateNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(entries []fs.DirEntry) {
+		for _, entry := range entries {
+			if name := strings.TrimSuffix(entry.Name(), templateExtension); !entry.IsDir() && name != entry.Name() && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	if entries, err := os.ReadDir(TemplatesDir); err == nil {
+		add(entries)
+	}
+	if entries, err := builtinTemplates.ReadDir("templates"); err == nil {
+		add(entries)
+	}
+	sort.Strings(names)
+	return names
+}
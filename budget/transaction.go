@@ -0,0 +1,262 @@
+package budget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const (
+	// DateLayout is the layout used to read and write calendar dates
+	DateLayout = "2006-01-02"
+	// MonthLayout is the layout used to read and write calendar months
+	MonthLayout = "2006-01"
+)
+
+// Date describes a calendar date, written using DateLayout
+type Date struct {
+	time.Time
+}
+
+// Today returns the current calendar date
+func Today() Date {
+	now := time.Now()
+	return Date{time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseDate parses a calendar date written using DateLayout
+func ParseDate(value string) (Date, error) {
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", value)
+	}
+	return Date{date}, nil
+}
+
+// String implements fmt.Stringer for Date
+func (date Date) String() string {
+	return date.Format(DateLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler for Date
+func (date Date) MarshalText() ([]byte, error) {
+	return []byte(date.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Date
+func (date *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*date = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Date, which time.Time would otherwise write with a time of day
+func (date Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(date.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Date
+func (date *Date) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return date.UnmarshalText([]byte(text))
+}
+
+var (
+	errTransactionNotFound = errors.New("transaction not found")
+)
+
+// Transaction describes an actual amount of money received or spent on a given date.
+// Positive amounts are money received, while negative amounts are money spent, in the currency of the budget.
+type Transaction struct {
+	ID       int            // Identifier unique within a journal
+	Date     time.Time      // Date of the transaction
+	Amount   quantity.Money // Signed amount of the transaction
+	Payee    string         // Who was paid, or who paid
+	Memo     string         // Optional note
+	Category string         // Name of the linked expense or income source
+	ImportID string         // Identifies the statement entry the transaction was imported from, if any
+}
+
+type transactionJSON struct {
+	ID       int     `json:"id"`
+	Date     string  `json:"date"`
+	Amount   float64 `json:"amount"`
+	Payee    string  `json:"payee"`
+	Memo     string  `json:"memo,omitempty"`
+	Category string  `json:"category,omitempty"`
+	ImportID string  `json:"import_id,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler for Transaction
+func (transaction *Transaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(transactionJSON{
+		ID:       transaction.ID,
+		Date:     transaction.Date.Format(DateLayout),
+		Amount:   transaction.Amount.ValueOf(),
+		Payee:    transaction.Payee,
+		Memo:     transaction.Memo,
+		Category: transaction.Category,
+		ImportID: transaction.ImportID,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Transaction
+func (transaction *Transaction) UnmarshalJSON(data []byte) error {
+	var decoded transactionJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	date, err := time.Parse(DateLayout, decoded.Date)
+	if err != nil {
+		return fmt.Errorf("invalid transaction date: %w", err)
+	}
+
+	*transaction = Transaction{
+		ID:       decoded.ID,
+		Date:     date,
+		Amount:   quantity.Money(decoded.Amount),
+		Payee:    decoded.Payee,
+		Memo:     decoded.Memo,
+		Category: decoded.Category,
+		ImportID: decoded.ImportID,
+	}
+	return nil
+}
+
+// Journal describes the transactions actually recorded against a named budget
+type Journal struct {
+	name         string
+	NextID       int            `json:"next_id"`
+	Transactions []*Transaction `json:"transactions"`
+}
+
+// MakeJournal makes an empty journal for the named budget
+func MakeJournal(name string) *Journal {
+	return &Journal{
+		name:         name,
+		NextID:       1,
+		Transactions: make([]*Transaction, 0),
+	}
+}
+
+// LoadJournal loads the journal of the named budget from disk. If no journal has been saved yet, an empty journal is returned.
+func LoadJournal(name string) (*Journal, error) {
+	journal := MakeJournal(name)
+
+	fileReader, err := os.Open(fmt.Sprintf("%s.journal", name))
+	if errors.Is(err, os.ErrNotExist) {
+		return journal, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fileReader.Close()
+
+	decoder := json.NewDecoder(fileReader)
+	if err := decoder.Decode(journal); err != nil {
+		return nil, err
+	}
+
+	return journal, nil
+}
+
+// Save saves a journal to disk
+func (journal *Journal) Save() error {
+	fileWriter, err := os.Create(fmt.Sprintf("%s.journal", journal.name))
+	if err != nil {
+		return err
+	}
+	defer fileWriter.Close()
+
+	encoder := json.NewEncoder(fileWriter)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(journal); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Add records a transaction, assigning it the next available identifier
+func (journal *Journal) Add(transaction *Transaction) {
+	transaction.ID = journal.NextID
+	journal.NextID++
+	journal.Transactions = append(journal.Transactions, transaction)
+	journal.sort()
+}
+
+// Delete removes the transaction with the given identifier
+func (journal *Journal) Delete(id int) error {
+	for index, transaction := range journal.Transactions {
+		if transaction.ID == id {
+			journal.Transactions = append(journal.Transactions[:index], journal.Transactions[index+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %d", errTransactionNotFound, id)
+}
+
+// HasImported reports whether a transaction imported from the given statement entry was already recorded
+func (journal *Journal) HasImported(importID string) bool {
+	for _, transaction := range journal.Transactions {
+		if transaction.ImportID == importID {
+			return true
+		}
+	}
+	return false
+}
+
+// Between returns the transactions dated within [start, end), in chronological order
+func (journal *Journal) Between(start, end time.Time) []*Transaction {
+	transactions := make([]*Transaction, 0)
+	for _, transaction := range journal.Transactions {
+		if !transaction.Date.Before(start) && transaction.Date.Before(end) {
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions
+}
+
+// Totals sums the transactions dated within [start, end) by category.
+// Money spent is counted positively for expenses, and money received is counted positively for income.
+func (journal *Journal) Totals(budget *Budget, start, end time.Time) map[string]quantity.Money {
+	totals := make(map[string]quantity.Money)
+	for _, transaction := range journal.Between(start, end) {
+		if _, isExpense := budget.Expenses[transaction.Category]; isExpense {
+			totals[transaction.Category] -= transaction.Amount
+		} else {
+			totals[transaction.Category] += transaction.Amount
+		}
+	}
+	return totals
+}
+
+// MonthRange returns the first day of the given month, formatted as MonthLayout, and the first day of the following month
+func MonthRange(month string) (time.Time, time.Time, error) {
+	start, err := time.Parse(MonthLayout, month)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid month %q: expected YYYY-MM", month)
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// sort orders transactions chronologically, then by identifier
+func (journal *Journal) sort() {
+	sort.SliceStable(journal.Transactions, func(i, j int) bool {
+		if journal.Transactions[i].Date.Equal(journal.Transactions[j].Date) {
+			return journal.Transactions[i].ID < journal.Transactions[j].ID
+		}
+		return journal.Transactions[i].Date.Before(journal.Transactions[j].Date)
+	})
+}
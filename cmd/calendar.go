@@ -0,0 +1,104 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// calendarCmd represents the calendar command
+var calendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "projects cash flow day by day",
+	Long: `Projects the cash flow of a budget day by day for the next few months, starting
+from the given balance, such as "$1,500". Income is received on its pay dates, and
+expenses are paid on their due days, so timing problems hidden by monthly totals,
+such as rent due before payday, show up as days the balance runs low.
+
+Record due days with "budgetbuddy tag --due" and pay dates with "budgetbuddy payday";
+otherwise, expenses are assumed to be due on the 1st and income to be paid from the
+first day of the projection.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		calendarBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		currency := calendarBudget.ReportingCurrency()
+
+		balance, err := parseBudgetMoney(args[1], currency)
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid starting balance "%s"`, args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		threshold, err := parseBudgetMoney(viper.GetString("calendar_threshold"), currency)
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid threshold "%s"`, viper.GetString("calendar_threshold"))).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		start := budget.Today()
+		if from := viper.GetString("calendar_from"); from != "" {
+			if start, err = budget.ParseDate(from); err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+		months := viper.GetInt("calendar_months")
+		if months < 1 {
+			fmt.Println(termenv.String("The number of months to project must be at least 1").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		reports.ReportCalendar(calendarBudget, start.Time, start.AddDate(0, months, 0), balance, threshold)
+	},
+}
+
+// parseBudgetMoney parses an amount of money, converting it into the currency of a budget if written in another currency
+func parseBudgetMoney(value string, currency quantity.Currency) (quantity.Money, error) {
+	money, moneyCurrency, err := quantity.ParseMoney(value)
+	if err != nil {
+		return money, err
+	}
+	money = budget.Convert(money, moneyCurrency, currency, time.Now())
+	if money.IsNaN() {
+		return money, fmt.Errorf("no exchange rate from %s to %s", moneyCurrency, currency)
+	}
+	return money, nil
+}
+
+func init() {
+	rootCmd.AddCommand(calendarCmd)
+
+	calendarCmd.Flags().Int("months", 3, "The number of months to project")
+	viper.BindPFlag("calendar_months", calendarCmd.Flags().Lookup("months"))
+
+	calendarCmd.Flags().String("threshold", "0", "Warn about days the balance is projected to be below this amount")
+	viper.BindPFlag("calendar_threshold", calendarCmd.Flags().Lookup("threshold"))
+
+	calendarCmd.Flags().String("from", "", "The first day to project (YYYY-MM-DD); if empty, today")
+	viper.BindPFlag("calendar_from", calendarCmd.Flags().Lookup("from"))
+}
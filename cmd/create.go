@@ -31,15 +31,25 @@ import (
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "creates budgets",
-	Long:  `Interactively prompts the user to create a budget from scratch.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Interactively prompts the user to create a budget from scratch. With --template, the
+budget starts with the income sources and expenses of a template, such as one saved with
+"budgetbuddy template save" or one of those shipped with budgetbuddy, and the user is
+only prompted for the amounts left blank. List templates with "budgetbuddy template list".`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		// Initialize configuration for budget
-		budget.MinimumWage = viper.GetFloat64("minimum_wage")
-		budget.MinimumOvertimeHours = viper.GetFloat64("minimum_overtime_hours")
-
 		newBudget := budget.Make(args[0])
-		if err := surveys.AskBudgetSurvey(newBudget); err != nil {
+		ask := surveys.AskBudgetSurvey
+		if templateName := viper.GetString("template"); templateName != "" {
+			template, err := budget.LoadTemplate(templateName)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Could not load template "%s": %s`, templateName, err)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			newBudget = template.Budget(args[0])
+			ask = surveys.AskTemplateSurvey
+		}
+
+		if err := ask(newBudget); err != nil {
 			switch err {
 			case terminal.InterruptErr:
 				fmt.Println(termenv.String("Aborted budget creation").Foreground(termenv.ANSIRed))
@@ -73,4 +83,7 @@ func init() {
 
 	createCmd.Flags().Float64("minimum-overtime-hours", 40, "The legal minimum number of hours required for overtime pay")
 	viper.BindPFlag("minimum_overtime_hours", createCmd.Flags().Lookup("minimum-overtime-hours"))
+
+	createCmd.Flags().String("template", "", "Start the budget from a template, only prompting for the amounts left blank")
+	viper.BindPFlag("template", createCmd.Flags().Lookup("template"))
 }
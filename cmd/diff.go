@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "compares two budgets",
+	Long: `Compares two budgets, listing the income sources, expenses, one-time events and
+scenarios added, removed or modified from the first to the second, including changes
+to inputs such as the hours of wages or the volume of commissions, and the resulting
+change in monthly income, expenses and the amount remaining.
+
+Each budget is given by name, or by the path of a file, such as a copy of a budget
+kept as a backup. With --format json, the differences are written as JSON.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		before, beforeName := loadDiffBudget(args[0])
+		after, afterName := loadDiffBudget(args[1])
+		if beforeName == afterName {
+			beforeName, afterName = args[0], args[1]
+		}
+
+		diff := budget.Compare(before, after)
+		switch format, _ := cmd.Flags().GetString("format"); strings.ToLower(format) {
+		case "table":
+			reports.ReportDiff(diff, beforeName, afterName)
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "\t")
+			if err := encoder.Encode(diff); err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf("Could not write the differences as JSON: %s", err)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		default:
+			fmt.Println(termenv.String(fmt.Sprintf(`Unsupported diff format "%s"`, format)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringP("format", "f", "table", "The format to write the differences in (table or json)")
+}
+
+// loadDiffBudget loads a budget to compare, given by name or by the path of its file, returning it with its name
+func loadDiffBudget(arg string) (*budget.Budget, string) {
+	var diffBudget *budget.Budget
+	var err error
+	name := arg
+	if info, statErr := os.Stat(arg); statErr == nil && !info.IsDir() {
+		name = strings.TrimSuffix(filepath.Base(arg), ".budget")
+		diffBudget, err = budget.LoadFile(name, arg)
+	} else {
+		diffBudget, err = budget.Load(arg)
+	}
+	if err != nil {
+		fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s"`, arg)).Foreground(termenv.ANSIRed))
+		os.Exit(1)
+	}
+	return diffBudget, name
+}
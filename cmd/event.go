@@ -0,0 +1,98 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// eventCmd represents the event command
+var eventCmd = &cobra.Command{
+	Use:   "event",
+	Short: "records one-time events",
+	Long: `Records a one-time event of a budget for forecasts, such as the purchase of a car
+on a date (YYYY-MM-DD) for an amount, such as "$20,000". Events are money spent,
+unless --received is given, as for a bonus. Recording an event with the name of
+another event replaces it, and --remove removes it.`,
+	Args: cobra.RangeArgs(2, 4),
+	Run: func(cmd *cobra.Command, args []string) {
+		eventBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if remove, _ := cmd.Flags().GetBool("remove"); remove {
+			if _, ok := eventBudget.Events[args[1]]; !ok {
+				fmt.Println(termenv.String(fmt.Sprintf(`Budget "%s" has no event named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			delete(eventBudget.Events, args[1])
+			if err := eventBudget.Save(); err != nil {
+				panic(err)
+			}
+			fmt.Println(termenv.String(fmt.Sprintf(`Removed event "%s"`, args[1])).Foreground(termenv.ANSIGreen))
+			return
+		}
+		if len(args) != 4 {
+			fmt.Println(termenv.String("Give the date and amount of the event").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		date, err := budget.ParseDate(args[2])
+		if err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		amount, currency, err := quantity.ParseMoney(args[3])
+		if err != nil || amount <= 0 {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid amount "%s"`, args[3])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		if currency == eventBudget.ReportingCurrency() {
+			currency = ""
+		}
+		formatted := amount.Format(currency.Or(eventBudget.ReportingCurrency()))
+		verb := "spent"
+		if received, _ := cmd.Flags().GetBool("received"); received {
+			verb = "received"
+		} else {
+			amount = -amount
+		}
+
+		if eventBudget.Events == nil {
+			eventBudget.Events = make(budget.EventList)
+		}
+		eventBudget.Events[args[1]] = &budget.Event{Date: date, Amount: amount, Currency: currency}
+		if err := eventBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Recorded event "%s": %s %s on %s`, args[1], formatted, verb, date)).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(eventCmd)
+
+	eventCmd.Flags().Bool("received", false, "The amount of the event is received, rather than spent")
+	eventCmd.Flags().Bool("remove", false, "Remove the event")
+}
@@ -0,0 +1,194 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/exports"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "exports budgets to other tools",
+	Long: `Exports a budget to other tools.
+
+The ledger, hledger and beancount formats render the monthly amounts of each income
+source and expense as periodic transactions or budget directives. Accounts are named
+after the income sources and expenses under --income-account and --expense-account,
+unless mapped in the "accounts" section of the config file:
+
+	{"accounts": {"Rent": "Expenses:Housing:Rent"}}
+
+The ics format writes an iCalendar file of recurring events for each payday and bill
+due date, with the amounts in their summaries, to import into calendar apps. Record
+pay dates with "budgetbuddy payday" and due days with "budgetbuddy tag --due"; other
+income sources and expenses are left out. With --remind, each event has an alarm the
+given number of days before.
+
+The xlsx and ods formats write a spreadsheet, for Excel or LibreOffice, with sheets of
+the income sources and their inputs (rates, hours, items and volumes), the expenses and
+a summary. Monthly amounts, conversions to the reporting currency and totals are live
+formulas of those inputs and of the net pay percentage, overtime hours and exchange
+rates on the summary sheet, so editing any of them updates the rest. Exchange rates
+missing from the exchange rates file are left as #N/A on the summary sheet, to be
+filled in. Spreadsheets require --output.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		exportBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		// Spreadsheets can be exported without some exchange rates, which are left to be filled in on the summary sheet
+		format := strings.ToLower(viper.GetString("export_format"))
+		spreadsheet := format == "xlsx" || format == "ods"
+		if errs := exportBudget.MissingExchangeRates(); len(errs) > 0 {
+			for _, err := range errs {
+				if spreadsheet {
+					fmt.Fprintln(os.Stderr, termenv.String(fmt.Sprintf("Warning: %s; add it to the exchange rates file, or to the summary sheet.", err)).Foreground(termenv.ANSIYellow))
+				} else {
+					fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				}
+			}
+			if !spreadsheet {
+				os.Exit(1)
+			}
+		}
+
+		start := time.Now()
+		start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if from := viper.GetString("export_from"); from != "" {
+			if start, _, err = budget.MonthRange(from); err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		accounts := exports.Accounts{
+			Income:   viper.GetString("income_account"),
+			Expenses: viper.GetString("expense_account"),
+			Assets:   viper.GetString("asset_account"),
+			Names:    viper.GetStringMapString("accounts"),
+		}
+
+		// The exporter is chosen before the output file is created, so that an unsupported format leaves it untouched
+		var export func(writer io.Writer) error
+		switch format {
+		case "ledger":
+			export = func(writer io.Writer) error {
+				return exports.WriteLedger(writer, args[0], exportBudget, accounts, start)
+			}
+		case "hledger":
+			export = func(writer io.Writer) error {
+				return exports.WriteHledger(writer, args[0], exportBudget, accounts, start)
+			}
+		case "beancount":
+			export = func(writer io.Writer) error {
+				return exports.WriteBeancount(writer, args[0], exportBudget, accounts, start)
+			}
+		case "ics", "ical", "icalendar":
+			export = func(writer io.Writer) error {
+				defer reportUnscheduled(exportBudget)
+				return exports.WriteICS(writer, args[0], exportBudget, start, viper.GetInt("remind_days"))
+			}
+		case "xlsx":
+			export = func(writer io.Writer) error {
+				return exports.WriteXLSX(writer, args[0], exportBudget)
+			}
+		case "ods":
+			export = func(writer io.Writer) error {
+				return exports.WriteODS(writer, args[0], exportBudget)
+			}
+		default:
+			fmt.Println(termenv.String(fmt.Sprintf(`Unsupported export format "%s"`, format)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		if spreadsheet && viper.GetString("export_output") == "" {
+			fmt.Println(termenv.String(fmt.Sprintf(`Exporting to %s requires --output`, format)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		var writer io.Writer = os.Stdout
+		if output := viper.GetString("export_output"); output != "" {
+			fileWriter, err := os.Create(output)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Could not create "%s"`, output)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			defer fileWriter.Close()
+			writer = fileWriter
+		}
+
+		if err := export(writer); err != nil {
+			panic(err)
+		}
+	},
+}
+
+// reportUnscheduled warns, on standard error so as not to mix with exports to standard output, about the income sources
+// without a pay date and expenses without a due day that were left out of a calendar
+func reportUnscheduled(exportBudget *budget.Budget) {
+	var unscheduled []string
+	for _, name := range exportBudget.Income.SortedNames() {
+		if exportBudget.Income[name].Attributes().PaidOn == nil {
+			unscheduled = append(unscheduled, name)
+		}
+	}
+	for _, name := range exportBudget.Expenses.SortedNames() {
+		if exportBudget.Expenses[name].Due == 0 {
+			unscheduled = append(unscheduled, name)
+		}
+	}
+	if len(unscheduled) > 0 {
+		fmt.Fprintln(os.Stderr, termenv.String(fmt.Sprintf("Left out without a pay date or due day: %s", strings.Join(unscheduled, ", "))).Foreground(termenv.ANSIYellow))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringP("format", "f", "ledger", "The format to export to (ledger, hledger, beancount, ics, xlsx or ods)")
+	viper.BindPFlag("export_format", exportCmd.Flags().Lookup("format"))
+
+	exportCmd.Flags().StringP("output", "o", "", "The file to export to (default is standard output)")
+	viper.BindPFlag("export_output", exportCmd.Flags().Lookup("output"))
+
+	exportCmd.Flags().String("from", "", "The first month the budget applies to (YYYY-MM, default is the current month)")
+	viper.BindPFlag("export_from", exportCmd.Flags().Lookup("from"))
+
+	exportCmd.Flags().Int("remind", 0, "Add alarms this many days before paydays and due dates to iCalendar exports (0 for none)")
+	viper.BindPFlag("remind_days", exportCmd.Flags().Lookup("remind"))
+
+	exportCmd.Flags().String("income-account", "Income", "The parent account of income sources")
+	viper.BindPFlag("income_account", exportCmd.Flags().Lookup("income-account"))
+
+	exportCmd.Flags().String("expense-account", "Expenses", "The parent account of expenses")
+	viper.BindPFlag("expense_account", exportCmd.Flags().Lookup("expense-account"))
+
+	exportCmd.Flags().String("asset-account", "Assets:Checking", "The account that receives income and pays expenses")
+	viper.BindPFlag("asset_account", exportCmd.Flags().Lookup("asset-account"))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// forecastCmd represents the forecast command
+var forecastCmd = &cobra.Command{
+	Use:   "forecast",
+	Short: "projects budgets over years",
+	Long: `Projects the income and expenses of a budget month by month for a number of years,
+with running totals of cumulative savings and net worth, starting from the recorded
+savings balance or --balance.
+
+Income sources receive raises, and expenses inflate at their own rates, both set
+with "budgetbuddy schedule", which also sets when income sources and expenses start
+and end. Other expenses inflate at the rate assumed with --inflation, or by the
+"inflation" key of the config file. One-time events, such as a bonus or the
+purchase of a car, are recorded with "budgetbuddy event".`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		forecastBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		years := viper.GetInt("forecast_years")
+		if years < 1 {
+			fmt.Println(termenv.String("The number of years to forecast must be at least 1").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		inflation, err := quantity.NewPercentage(viper.GetString("inflation"))
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid rate of inflation "%s"; expected a percentage, such as 2.5%%`, viper.GetString("inflation"))).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		balance := forecastBudget.Savings
+		if balanceValue := viper.GetString("forecast_balance"); balanceValue != "" {
+			if balance, err = parseBudgetMoney(balanceValue, forecastBudget.ReportingCurrency()); err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Invalid starting balance "%s"`, balanceValue)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		start := time.Now()
+		if from := viper.GetString("forecast_from"); from != "" {
+			if start, _, err = budget.MonthRange(from); err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		forecast := forecastBudget.Forecast(start, 12*years, inflation, balance)
+		reports.ReportForecast(forecastBudget, forecast, viper.GetBool("forecast_monthly"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(forecastCmd)
+
+	forecastCmd.Flags().Int("years", 5, "The number of years to forecast")
+	viper.BindPFlag("forecast_years", forecastCmd.Flags().Lookup("years"))
+
+	forecastCmd.Flags().String("inflation", "0%", "The yearly rate of inflation of expenses without their own rate, such as 2.5%")
+	viper.BindPFlag("inflation", forecastCmd.Flags().Lookup("inflation"))
+
+	forecastCmd.Flags().String("balance", "", "The starting balance (default is the recorded savings balance)")
+	viper.BindPFlag("forecast_balance", forecastCmd.Flags().Lookup("balance"))
+
+	forecastCmd.Flags().String("from", "", "The first month to forecast (YYYY-MM, default is the current month)")
+	viper.BindPFlag("forecast_from", forecastCmd.Flags().Lookup("from"))
+
+	forecastCmd.Flags().Bool("monthly", false, "Show the forecast month by month, as well as by year")
+	viper.BindPFlag("forecast_monthly", forecastCmd.Flags().Lookup("monthly"))
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// householdCmd represents the household command
+var householdCmd = &cobra.Command{
+	Use:   "household",
+	Short: "combines the budgets of a household",
+	Long: `Combines the budgets of the members of a household, each kept in a budget of their
+own, with the expenses they share. Households are stored in "<household>.household",
+which refers to the budgets of its members by name.
+
+Shared expenses are split between members in proportion to their income, equally, or
+by a fixed share for each member, such as "alice=60%,bob=40%".`,
+}
+
+func init() {
+	rootCmd.AddCommand(householdCmd)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// householdCreateCmd represents the household create command
+var householdCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "creates a household",
+	Long: `Creates a household of the budgets of its members, given by name, such as
+"budgetbuddy household create home alice bob". Shared expenses are added with
+"budgetbuddy household share".`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if _, err := os.Stat(fmt.Sprintf("%s.household", args[0])); err == nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Household "%s.household" already exists`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		household := budget.MakeHousehold(args[0], args[1:])
+		seen := make(map[string]bool)
+		for _, member := range household.Members {
+			if seen[member] {
+				fmt.Println(termenv.String(fmt.Sprintf(`Member "%s" is given more than once`, member)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			seen[member] = true
+		}
+		if _, err := household.LoadMembers(); err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		currency, _ := cmd.Flags().GetString("currency")
+		household.Currency = quantity.Currency(strings.ToUpper(currency))
+
+		splitValue, _ := cmd.Flags().GetString("split")
+		split, err := parseSplit(household, splitValue)
+		if err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		household.Split = split
+
+		if err := household.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Created household "%s" of %s`, args[0], strings.Join(household.Members, ", "))).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	householdCmd.AddCommand(householdCreateCmd)
+
+	householdCreateCmd.Flags().String("split", budget.IncomeSplit, `How shared expenses are split: income, equal, or shares such as "alice=60%,bob=40%"`)
+	householdCreateCmd.Flags().String("currency", "", "The currency the household is reported in")
+}
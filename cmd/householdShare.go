@@ -0,0 +1,82 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// householdShareCmd represents the household share command
+var householdShareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "records shared expenses",
+	Long: `Records an expense shared by the members of a household, such as "$2,000" of rent,
+or "$600/quarter" of utilities. Costs per another period than a month are rescaled to
+monthly amounts. With --remove, the shared expense is removed instead.`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		household, err := budget.LoadHousehold(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load household "%s.household"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if remove, _ := cmd.Flags().GetBool("remove"); remove {
+			if _, ok := household.Shared[args[1]]; !ok {
+				fmt.Println(termenv.String(fmt.Sprintf(`Household "%s" has no shared expense named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			delete(household.Shared, args[1])
+			if err := household.Save(); err != nil {
+				panic(err)
+			}
+			fmt.Println(termenv.String(fmt.Sprintf(`Removed shared expense "%s"`, args[1])).Foreground(termenv.ANSIGreen))
+			return
+		}
+
+		if len(args) != 3 {
+			fmt.Println(termenv.String("Give the cost of the shared expense").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		rate, err := quantity.NewRate(args[2])
+		if err != nil || rate.Money <= 0 || !rate.Per.IsCalendar() {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid cost "%s"`, args[2])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		expense := &budget.Expense{Amount: rate.Monthly()}
+		if rate.Currency != household.ReportingCurrency() {
+			expense.Currency = rate.Currency
+		}
+		household.Shared[args[1]] = expense
+
+		if err := household.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Recorded shared expense "%s" of %s per month`, args[1], expense.Amount.Format(expense.Currency.Or(household.ReportingCurrency())))).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	householdCmd.AddCommand(householdShareCmd)
+
+	householdShareCmd.Flags().Bool("remove", false, "Remove the shared expense")
+}
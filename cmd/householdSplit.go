@@ -0,0 +1,81 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// householdSplitCmd represents the household split command
+var householdSplitCmd = &cobra.Command{
+	Use:   "split",
+	Short: "sets how shared expenses are split",
+	Long: `Sets how the shared expenses of a household are split between its members: "income"
+in proportion to the income of each member, "equal" equally between members, or a
+fixed share for each member, such as "alice=60%,bob=40%", adding up to 100%.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		household, err := budget.LoadHousehold(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load household "%s.household"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		split, err := parseSplit(household, args[1])
+		if err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		household.Split = split
+
+		if err := household.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Shared expenses of "%s" are now split by %s`, args[0], args[1])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	householdCmd.AddCommand(householdSplitCmd)
+}
+
+// parseSplit parses how the shared expenses of a household are split: by a method, such as income or equal, or by the share of each
+// member, such as "alice=60%,bob=40%"
+func parseSplit(household *budget.Household, value string) (budget.Split, error) {
+	if !strings.Contains(value, "=") {
+		return household.NewSplit(strings.ToLower(value), nil)
+	}
+	ratios := make(map[string]quantity.Percentage)
+	for _, part := range strings.Split(value, ",") {
+		member, ratioValue := part, ""
+		if index := strings.Index(part, "="); index >= 0 {
+			member, ratioValue = part[:index], part[index+1:]
+		}
+		ratio, err := quantity.NewPercentage(ratioValue)
+		if err != nil || ratio < 0 {
+			return budget.Split{}, fmt.Errorf(`Invalid share "%s"; expected a member and a percentage, such as alice=60%%`, part)
+		}
+		ratios[strings.TrimSpace(member)] = ratio
+	}
+	return household.NewSplit(budget.RatioSplit, ratios)
+}
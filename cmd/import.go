@@ -0,0 +1,180 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/sorucoder/budgetbuddy/statements"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "imports bank statements",
+	Long: `Imports transactions from bank-exported statements into the journal of a budget.
+Statements may be CSV files, OFX or QFX files (SGML or XML), or QIF files.
+
+Transactions that were already imported are skipped. The rest are linked to an expense
+or income source using the rules in "<budget>.rules", a JSON list such as:
+
+	[
+		{"category": "Groceries", "payee": "(?i)market|grocer"},
+		{"category": "Rent", "payee": "(?i)property", "minimum": 1000, "maximum": 2000}
+	]
+
+Rows that no rule matches are listed for review.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		importBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		journal, err := budget.LoadJournal(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load journal "%s.journal"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		rules, err := budget.LoadRules(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load rules "%s.rules": %s`, args[0], err)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		for _, rule := range rules {
+			_, isIncome := importBudget.Income[rule.Category]
+			_, isExpense := importBudget.Expenses[rule.Category]
+			if !isIncome && !isExpense {
+				fmt.Println(termenv.String(fmt.Sprintf(`Warning: rule category "%s" is not an expense or income source`, rule.Category)).Foreground(termenv.ANSIYellow))
+			}
+		}
+
+		var unmatched []*budget.Transaction
+		for _, path := range args[1:] {
+			transactions, err := readStatement(path)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Could not read statement "%s": %s`, path, err)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+
+			result := statements.Import(journal, rules, transactions)
+			fmt.Printf("%s: imported %d, skipped %d already imported\n", path, result.Imported, result.Duplicates)
+			unmatched = append(unmatched, result.Unmatched...)
+		}
+
+		if err := journal.Save(); err != nil {
+			panic(err)
+		}
+
+		if len(unmatched) > 0 {
+			fmt.Println()
+			reports.ReportTransactions("Unmatched Transactions", unmatched, importBudget.ReportingCurrency())
+		}
+	},
+}
+
+// readStatement reads the transactions of a statement, in the format given by --format or by its extension
+func readStatement(path string) ([]*budget.Transaction, error) {
+	format := strings.ToLower(viper.GetString("import_format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	fileReader, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fileReader.Close()
+
+	switch format {
+	case "csv":
+		delimiter, _ := utf8.DecodeRuneInString(viper.GetString("csv_delimiter"))
+		locale, err := quantity.NewLocale(viper.GetString("csv_locale"))
+		if err != nil {
+			return nil, err
+		}
+		return statements.ReadCSV(fileReader, statements.CSVMapping{
+			Delimiter:    delimiter,
+			Header:       viper.GetBool("csv_header"),
+			DateColumn:   viper.GetString("csv_date_column"),
+			DateFormat:   viper.GetString("csv_date_format"),
+			Locale:       locale,
+			AmountColumn: viper.GetString("csv_amount_column"),
+			DebitColumn:  viper.GetString("csv_debit_column"),
+			CreditColumn: viper.GetString("csv_credit_column"),
+			PayeeColumn:  viper.GetString("csv_payee_column"),
+			MemoColumn:   viper.GetString("csv_memo_column"),
+		})
+	case "ofx", "qfx":
+		return statements.ReadOFX(fileReader)
+	case "qif":
+		return statements.ReadQIF(fileReader, viper.GetString("qif_date_format"))
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().String("format", "", "The format of the statements (csv, ofx, qfx or qif); detected from the file extension by default")
+	viper.BindPFlag("import_format", importCmd.Flags().Lookup("format"))
+
+	importCmd.Flags().String("qif-date-format", "MM/DD/YYYY", "The order of the day, month and year in dates of QIF statements")
+	viper.BindPFlag("qif_date_format", importCmd.Flags().Lookup("qif-date-format"))
+
+	importCmd.Flags().String("csv-delimiter", ",", "The field delimiter of CSV statements")
+	viper.BindPFlag("csv_delimiter", importCmd.Flags().Lookup("csv-delimiter"))
+
+	importCmd.Flags().Bool("csv-header", true, "Whether the first row of CSV statements names the columns")
+	viper.BindPFlag("csv_header", importCmd.Flags().Lookup("csv-header"))
+
+	importCmd.Flags().String("csv-date-column", "Date", "The name or position of the date column of CSV statements")
+	viper.BindPFlag("csv_date_column", importCmd.Flags().Lookup("csv-date-column"))
+
+	importCmd.Flags().String("csv-date-format", "YYYY-MM-DD", "The date format of CSV statements, such as MM/DD/YYYY, M/D/YYYY or DD.MM.YY")
+	viper.BindPFlag("csv_date_format", importCmd.Flags().Lookup("csv-date-format"))
+
+	importCmd.Flags().String("csv-locale", "en-US", fmt.Sprintf("The locale amounts of CSV statements are written in, such as de-DE for 1.234,56, regardless of --locale (one of %s)", strings.Join(quantity.Locales(), ", ")))
+	viper.BindPFlag("csv_locale", importCmd.Flags().Lookup("csv-locale"))
+
+	importCmd.Flags().String("csv-amount-column", "Amount", "The name or position of the signed amount column of CSV statements")
+	viper.BindPFlag("csv_amount_column", importCmd.Flags().Lookup("csv-amount-column"))
+
+	importCmd.Flags().String("csv-debit-column", "", "The name or position of the debit column of CSV statements, used instead of the amount column")
+	viper.BindPFlag("csv_debit_column", importCmd.Flags().Lookup("csv-debit-column"))
+
+	importCmd.Flags().String("csv-credit-column", "", "The name or position of the credit column of CSV statements, used instead of the amount column")
+	viper.BindPFlag("csv_credit_column", importCmd.Flags().Lookup("csv-credit-column"))
+
+	importCmd.Flags().String("csv-payee-column", "Description", "The name or position of the payee column of CSV statements")
+	viper.BindPFlag("csv_payee_column", importCmd.Flags().Lookup("csv-payee-column"))
+
+	importCmd.Flags().String("csv-memo-column", "", "The name or position of the memo column of CSV statements")
+	viper.BindPFlag("csv_memo_column", importCmd.Flags().Lookup("csv-memo-column"))
+}
@@ -0,0 +1,82 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// paydayCmd represents the payday command
+var paydayCmd = &cobra.Command{
+	Use:   "payday",
+	Short: "records when income is paid",
+	Long: `Records the date of any paycheck of an income source of a budget (YYYY-MM-DD), so
+later paychecks can be projected from it every period the income source is paid.
+How often it is paid may be given with --every, such as weekly, biweekly,
+semimonthly or monthly.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		paydayBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		income, ok := paydayBudget.Income[args[1]]
+		if !ok {
+			fmt.Println(termenv.String(fmt.Sprintf(`Budget "%s" has no income source named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		paidOn, err := budget.ParseDate(args[2])
+		if err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		income.Attributes().PaidOn = &paidOn
+
+		if cmd.Flags().Changed("every") {
+			every, _ := cmd.Flags().GetString("every")
+			paidEvery, err := quantity.NewPeriod(every)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Invalid pay period "%s"`, every)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			income.Attributes().PaidEvery = &paidEvery
+		}
+
+		if err := paydayBudget.Save(); err != nil {
+			panic(err)
+		}
+		message := fmt.Sprintf(`Recorded that "%s" was paid on %s`, args[1], paidOn)
+		if paidEvery := income.Attributes().PaidEvery; paidEvery != nil {
+			message += fmt.Sprintf(", and is paid every %s", paidEvery)
+		}
+		fmt.Println(termenv.String(message).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(paydayCmd)
+
+	paydayCmd.Flags().String("every", "", "How often the income source is paid, such as weekly, biweekly, semimonthly or monthly")
+}
@@ -16,11 +16,15 @@ limitations under the License.
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/muesli/termenv"
 	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 	"github.com/sorucoder/budgetbuddy/reports"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -30,17 +34,112 @@ import (
 var reportCmd = &cobra.Command{
 	Use:   "report",
 	Short: "generates reports on created budgets",
-	Long:  `Generates reports on budgets`,
+	Long: `Generates reports on budgets. When a month is given with --period, the budget is
+compared to the transactions recorded in its journal for that month. Otherwise, --period
+rescales the report to weekly, biweekly, semimonthly, monthly, quarterly or annual amounts.
+With --paycheck, each expense is divided across the paychecks of an income source, paid
+as often as given by --paid-every or when the income source was created. With --charts,
+the report ends with charts of spending drawn to fit the terminal. With --rule, the
+expenses are graded by their buckets against an allocation rule, such as 50/30/20.
+With --format html, the report is written as a single self-contained HTML page, with
+tables, breakdowns of the expenses by bucket and kind, and charts, to --output or
+standard output, for sharing with those who do not use budgetbuddy.
+
+The thresholds of the financial health metrics may be set in the config file under
+"health", as savings_rate, expense_ratio, debt_ratio, housing_ratio and
+fixed_expense_ratio percentages, and emergency_fund_months.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		budget.NetPayPercentage = viper.GetFloat64("net_pay_percentage")
-
 		reportBudget, err := budget.Load(args[0])
 		if err != nil {
 			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
 			os.Exit(1)
 		}
-		reports.ReportBudget(reportBudget)
+
+		format := strings.ToLower(viper.GetString("report_format"))
+		if format != "text" && format != "html" {
+			fmt.Println(termenv.String(fmt.Sprintf(`Unsupported report format "%s"`, format)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		period := quantity.Month
+		if periodValue := viper.GetString("period"); periodValue != "" && periodValue != "paycheck" {
+			if month, _, err := budget.MonthRange(periodValue); err == nil {
+				if format == "html" {
+					fmt.Println(termenv.String("HTML reports cannot compare a budget to the transactions of a month").Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				journal, err := budget.LoadJournal(args[0])
+				if err != nil {
+					fmt.Println(termenv.String(fmt.Sprintf(`Could not load journal "%s.journal"`, args[0])).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+
+				reports.ReportVariance(reportBudget, journal, month)
+				if viper.GetBool("charts") {
+					fmt.Println()
+					reports.ReportSpendingChart(reportBudget, journal, month)
+				}
+				return
+			}
+
+			period, err = quantity.NewCalendarPeriod(periodValue)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Invalid period "%s": expected a month (YYYY-MM), a period such as weekly, biweekly, semimonthly, monthly, quarterly or annual, or paycheck`, periodValue)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		if format == "html" {
+			if viper.GetString("paycheck") != "" || viper.GetString("period") == "paycheck" {
+				fmt.Println(termenv.String("HTML reports cannot divide expenses across paychecks").Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+
+			var writer io.Writer = os.Stdout
+			if output := viper.GetString("report_output"); output != "" {
+				fileWriter, err := os.Create(output)
+				if err != nil {
+					fmt.Println(termenv.String(fmt.Sprintf(`Could not create "%s"`, output)).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				defer fileWriter.Close()
+				writer = fileWriter
+			}
+			if err := reports.WriteHTML(writer, args[0], reportBudget, period); err != nil {
+				panic(err)
+			}
+			return
+		}
+
+		if name := viper.GetString("paycheck"); name != "" || viper.GetString("period") == "paycheck" {
+			name, paidEvery, err := paycheckSource(reportBudget, name, viper.GetString("paid_every"))
+			if err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			reports.ReportPaycheck(reportBudget, name, paidEvery)
+			return
+		}
+
+		var rule *budget.AllocationRule
+		if ruleName := viper.GetString("allocation_rule"); ruleName != "" {
+			rule, err = budget.FindAllocationRule(ruleName)
+			if err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		reports.ReportBudget(reportBudget, period)
+		if rule != nil {
+			fmt.Println()
+			reports.ReportAllocation(reportBudget, rule, period)
+		}
+		if viper.GetBool("charts") {
+			fmt.Println()
+			reports.ReportCharts(reportBudget, period)
+		}
 	},
 }
 
@@ -57,6 +156,58 @@ func init() {
 	// is called directly, e.g.:
 	// reportCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 
-	createCmd.Flags().Float64("net-pay-percentage", 0.75, "The estimated percentage used to calculate net pay from gross pay")
-	viper.BindPFlag("net_pay_percentage", createCmd.Flags().Lookup("net-pay-percentage"))
+	reportCmd.Flags().String("period", "", "Compare the budget to the transactions recorded in the given month (YYYY-MM), or rescale it to a period such as weekly, biweekly, semimonthly, quarterly, annual or paycheck")
+	viper.BindPFlag("period", reportCmd.Flags().Lookup("period"))
+
+	reportCmd.Flags().String("paycheck", "", "Divide expenses across the paychecks of the named income source")
+	viper.BindPFlag("paycheck", reportCmd.Flags().Lookup("paycheck"))
+
+	reportCmd.Flags().String("paid-every", "", "How often the income source of --paycheck is paid, such as weekly or biweekly")
+	viper.BindPFlag("paid_every", reportCmd.Flags().Lookup("paid-every"))
+
+	reportCmd.Flags().String("rule", "", "Grade the allocation of expenses between buckets against a rule, such as 50/30/20, 70/20/10 or one defined in the config file")
+	viper.BindPFlag("allocation_rule", reportCmd.Flags().Lookup("rule"))
+
+	reportCmd.Flags().Bool("charts", false, "Draw charts of spending after the report")
+	viper.BindPFlag("charts", reportCmd.Flags().Lookup("charts"))
+
+	reportCmd.Flags().StringP("format", "f", "text", "The format of the report (text or html)")
+	viper.BindPFlag("report_format", reportCmd.Flags().Lookup("format"))
+
+	reportCmd.Flags().StringP("output", "o", "", "The file to write HTML reports to (default is standard output)")
+	viper.BindPFlag("report_output", reportCmd.Flags().Lookup("output"))
+}
+
+// paycheckSource finds the income source to divide expenses across and how often it is paid. If no income source is named,
+// the only income source with a known pay period is used.
+func paycheckSource(reportBudget *budget.Budget, name string, paidEvery string) (string, quantity.Period, error) {
+	if name == "" {
+		for incomeName, income := range reportBudget.Income {
+			if income.Attributes().PaidEvery != nil {
+				if name != "" {
+					return "", quantity.Period{}, errors.New("More than one income source is paid regularly; choose one with --paycheck")
+				}
+				name = incomeName
+			}
+		}
+		if name == "" {
+			return "", quantity.Period{}, errors.New("No income source is paid regularly; choose one with --paycheck and --paid-every")
+		}
+	}
+
+	income, ok := reportBudget.Income[name]
+	if !ok {
+		return "", quantity.Period{}, fmt.Errorf(`No income source named "%s"`, name)
+	}
+	if paidEvery != "" {
+		period, err := quantity.NewPeriod(paidEvery)
+		if err != nil {
+			return "", quantity.Period{}, fmt.Errorf(`Invalid pay period "%s"`, paidEvery)
+		}
+		return name, period, nil
+	}
+	if income.Attributes().PaidEvery == nil {
+		return "", quantity.Period{}, fmt.Errorf(`It is unknown how often "%s" is paid; give it with --paid-every`, name)
+	}
+	return name, *income.Attributes().PaidEvery, nil
 }
@@ -18,7 +18,11 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -50,6 +54,21 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.budgetbuddy.json)")
 
+	rootCmd.PersistentFlags().String("exchange-rates", "", "exchange rates file of date,from,to,rate lines (default is $HOME/.budgetbuddy.rates)")
+	viper.BindPFlag("exchange_rates", rootCmd.PersistentFlags().Lookup("exchange-rates"))
+
+	rootCmd.PersistentFlags().String("templates", "", "directory of saved budget templates (default is $HOME/.budgetbuddy.templates)")
+	viper.BindPFlag("templates", rootCmd.PersistentFlags().Lookup("templates"))
+
+	rootCmd.PersistentFlags().String("locale", "en-US", fmt.Sprintf("locale quantities are read and written in (one of %s)", strings.Join(quantity.Locales(), ", ")))
+	viper.BindPFlag("locale", rootCmd.PersistentFlags().Lookup("locale"))
+
+	rootCmd.PersistentFlags().String("negative-format", "", "format of negative amounts, where %s is the absolute amount, such as (%s) for accounting (default is the locale's)")
+	viper.BindPFlag("negative_format", rootCmd.PersistentFlags().Lookup("negative-format"))
+
+	rootCmd.PersistentFlags().Float64("net-pay-percentage", 0.75, "The estimated percentage used to calculate net pay from gross pay")
+	viper.BindPFlag("net_pay_percentage", rootCmd.PersistentFlags().Lookup("net-pay-percentage"))
+
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
 	// rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
@@ -77,4 +96,60 @@ func initConfig() {
 	if err := viper.ReadInConfig(); err == nil {
 		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 	}
+
+	// Initialize configuration for quantities
+	locale, err := quantity.NewLocale(viper.GetString("locale"))
+	cobra.CheckErr(err)
+	if negativeFormat := viper.GetString("negative_format"); negativeFormat != "" {
+		locale, err = locale.WithNegativeFormat(negativeFormat)
+		cobra.CheckErr(err)
+	}
+	quantity.DefaultLocale = locale
+
+	// Initialize configuration for budgets
+	budget.MinimumWage = viper.GetFloat64("minimum_wage")
+	budget.MinimumOvertimeHours = viper.GetFloat64("minimum_overtime_hours")
+	budget.NetPayPercentage = viper.GetFloat64("net_pay_percentage")
+	for key, threshold := range map[string]*quantity.Percentage{
+		"health.savings_rate":        &budget.HealthThresholds.SavingsRate,
+		"health.expense_ratio":       &budget.HealthThresholds.ExpenseRatio,
+		"health.debt_ratio":          &budget.HealthThresholds.DebtRatio,
+		"health.housing_ratio":       &budget.HealthThresholds.HousingRatio,
+		"health.fixed_expense_ratio": &budget.HealthThresholds.FixedExpenseRatio,
+	} {
+		if viper.IsSet(key) {
+			percentage, err := quantity.NewPercentage(viper.Get(key))
+			cobra.CheckErr(err)
+			*threshold = percentage
+		}
+	}
+	if viper.IsSet("health.emergency_fund_months") {
+		budget.HealthThresholds.EmergencyFundMonths = quantity.Number(viper.GetFloat64("health.emergency_fund_months"))
+	}
+	for name, sharesValue := range viper.GetStringMap("allocation_rules") {
+		shares, ok := sharesValue.(map[string]interface{})
+		if !ok {
+			cobra.CheckErr(fmt.Errorf("allocation rule %s: expected shares of buckets", name))
+		}
+		rule, err := budget.NewAllocationRule(name, shares)
+		cobra.CheckErr(err)
+		budget.AllocationRules[name] = rule
+	}
+
+	exchangeRatesFile := viper.GetString("exchange_rates")
+	if exchangeRatesFile == "" {
+		home, err := os.UserHomeDir()
+		cobra.CheckErr(err)
+		exchangeRatesFile = filepath.Join(home, ".budgetbuddy.rates")
+	}
+	exchangeRates, err := quantity.LoadExchangeRates(exchangeRatesFile)
+	cobra.CheckErr(err)
+	budget.ExchangeRates = exchangeRates
+
+	budget.TemplatesDir = viper.GetString("templates")
+	if budget.TemplatesDir == "" {
+		home, err := os.UserHomeDir()
+		cobra.CheckErr(err)
+		budget.TemplatesDir = filepath.Join(home, ".budgetbuddy.templates")
+	}
 }
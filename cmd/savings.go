@@ -0,0 +1,65 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// savingsCmd represents the savings command
+var savingsCmd = &cobra.Command{
+	Use:   "savings",
+	Short: "records savings balances",
+	Long: `Records the balance of savings of a budget, such as "$12,000" or "€9.500",
+so reports can show how many months of spending it covers as an emergency fund.
+Balances in another currency are converted into the currency of the budget.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		savingsBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		balance, currency, err := quantity.ParseMoney(args[1])
+		if err != nil || balance < 0 {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid savings balance "%s"`, args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		balance = budget.Convert(balance, currency, savingsBudget.ReportingCurrency(), time.Now())
+		if balance.IsNaN() {
+			fmt.Println(termenv.String(fmt.Sprintf("No exchange rate from %s to %s; add it to the exchange rates file", currency, savingsBudget.ReportingCurrency())).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		savingsBudget.Savings = balance
+		if err := savingsBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf("Recorded savings balance of %s", balance.Format(savingsBudget.ReportingCurrency()))).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(savingsCmd)
+}
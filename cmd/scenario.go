@@ -0,0 +1,34 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// scenarioCmd represents the scenario command
+var scenarioCmd = &cobra.Command{
+	Use:   "scenario",
+	Short: "manages what-if scenarios",
+	Long: `Manages what-if scenarios of a budget. A scenario is stored inside the budget as a
+set of changes on top of it: income and expenses added, changed or removed, and other
+assumptions, such as the net pay percentage or the savings balance. The budget itself is
+left unchanged, so scenarios can be compared to it side by side.`,
+}
+
+func init() {
+	rootCmd.AddCommand(scenarioCmd)
+}
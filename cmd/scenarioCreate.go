@@ -0,0 +1,78 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/surveys"
+	"github.com/spf13/cobra"
+)
+
+// scenarioCreateCmd represents the scenario create command
+var scenarioCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "creates or edits a scenario",
+	Long: `Interactively prompts the user for the changes a scenario makes to a budget. Income and
+expenses given the name of ones in the budget replace them. If the scenario already
+exists, its changes are added to.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		scenarioBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if scenarioBudget.Scenarios == nil {
+			scenarioBudget.Scenarios = make(budget.ScenarioList)
+		}
+		scenario, exists := scenarioBudget.Scenarios[args[1]]
+		if !exists {
+			scenario = budget.MakeScenario("")
+		}
+		if scenario.Income == nil {
+			scenario.Income = make(budget.IncomeList)
+		}
+		if scenario.Expenses == nil {
+			scenario.Expenses = make(budget.ExpenseList)
+		}
+
+		if err := surveys.AskScenarioSurvey(scenarioBudget, scenario); err != nil {
+			switch err {
+			case terminal.InterruptErr:
+				fmt.Println(termenv.String("Aborted scenario creation").Foreground(termenv.ANSIRed))
+				os.Exit(0)
+			default:
+				panic(err)
+			}
+		}
+
+		scenarioBudget.Scenarios[args[1]] = scenario
+		if err := scenarioBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Saved scenario "%s"`, args[1])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	scenarioCmd.AddCommand(scenarioCreateCmd)
+}
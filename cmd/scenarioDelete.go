@@ -0,0 +1,54 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/spf13/cobra"
+)
+
+// scenarioDeleteCmd represents the scenario delete command
+var scenarioDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "deletes a scenario",
+	Long:  `Deletes a scenario, by its name, from a budget. The budget itself is left unchanged.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		scenarioBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if _, ok := scenarioBudget.Scenarios[args[1]]; !ok {
+			fmt.Println(termenv.String(fmt.Sprintf(`No scenario named "%s"`, args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		delete(scenarioBudget.Scenarios, args[1])
+		if err := scenarioBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Deleted scenario "%s"`, args[1])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	scenarioCmd.AddCommand(scenarioDeleteCmd)
+}
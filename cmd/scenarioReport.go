@@ -0,0 +1,64 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+)
+
+// scenarioReportCmd represents the scenario report command
+var scenarioReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "reports on a scenario",
+	Long: `Reports on a budget as changed by a scenario, followed by a side-by-side comparison of
+the totals of the budget and the scenario. With --period, the report is rescaled to
+weekly, biweekly, semimonthly, monthly, quarterly or annual amounts.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		scenarioBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		period := quantity.Month
+		if periodValue, _ := cmd.Flags().GetString("period"); periodValue != "" {
+			period, err = quantity.NewCalendarPeriod(periodValue)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Invalid period "%s": expected a period such as weekly, biweekly, semimonthly, monthly, quarterly or annual`, periodValue)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+		}
+
+		if err := reports.ReportScenario(scenarioBudget, args[1], period); err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	scenarioCmd.AddCommand(scenarioReportCmd)
+
+	scenarioReportCmd.Flags().String("period", "", "Rescale the report to a period such as weekly, biweekly, semimonthly, quarterly or annual")
+}
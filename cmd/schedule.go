@@ -0,0 +1,176 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// scheduleCmd represents the schedule command
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "schedules changes to income and expenses",
+	Long: `Schedules how an income source or expense of a budget changes over time, for
+forecasts. Income sources and expenses may start and end on given dates
+(YYYY-MM-DD) using --start and --end, or "none" to remove them.
+
+Income sources may receive a raise, such as 3%, every year or every period given by
+--raise-every. Raises are received every period from the date given by --raised-on,
+such as that of the last raise, or else from the start date; when neither is known,
+the day the raise is scheduled is recorded. Expenses may inflate at their own yearly rate with --inflation, or
+"none" to inflate at the rate assumed by forecasts.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		scheduleBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		var schedule *budget.Schedule
+		var attributes *budget.IncomeAttributes
+		var expense *budget.Expense
+		if income, ok := scheduleBudget.Income[args[1]]; ok {
+			attributes = income.Attributes()
+			schedule = &attributes.Schedule
+		} else if expense, ok = scheduleBudget.Expenses[args[1]]; ok {
+			schedule = &expense.Schedule
+		} else {
+			fmt.Println(termenv.String(fmt.Sprintf(`Budget "%s" has no income source or expense named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		var changes []string
+		for _, flag := range []struct {
+			name string
+			date **budget.Date
+		}{
+			{"start", &schedule.Start},
+			{"end", &schedule.End},
+		} {
+			if !cmd.Flags().Changed(flag.name) {
+				continue
+			}
+			value, _ := cmd.Flags().GetString(flag.name)
+			if value == "none" {
+				*flag.date = nil
+				changes = append(changes, fmt.Sprintf("no %s date", flag.name))
+				continue
+			}
+			date, err := budget.ParseDate(value)
+			if err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			*flag.date = &date
+			changes = append(changes, fmt.Sprintf("%s date %s", flag.name, date))
+		}
+		if schedule.Start != nil && schedule.End != nil && schedule.End.Before(schedule.Start.Time) {
+			fmt.Println(termenv.String("The end date must not be before the start date").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if cmd.Flags().Changed("raise") || cmd.Flags().Changed("raise-every") || cmd.Flags().Changed("raised-on") {
+			if attributes == nil {
+				fmt.Println(termenv.String("Only income sources receive raises; use --inflation for expenses").Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			if cmd.Flags().Changed("raise") {
+				value, _ := cmd.Flags().GetString("raise")
+				raise, err := quantity.NewPercentage(value)
+				if err != nil {
+					fmt.Println(termenv.String(fmt.Sprintf(`Invalid raise "%s"; expected a percentage, such as 3%%`, value)).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				attributes.Raise = raise
+				changes = append(changes, fmt.Sprintf("raise of %s", raise))
+			}
+			if cmd.Flags().Changed("raise-every") {
+				value, _ := cmd.Flags().GetString("raise-every")
+				raiseEvery, err := quantity.NewCalendarPeriod(value)
+				if err != nil {
+					fmt.Println(termenv.String(fmt.Sprintf(`Invalid raise period "%s"`, value)).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				attributes.RaiseEvery = &raiseEvery
+				changes = append(changes, fmt.Sprintf("raises every %s", raiseEvery))
+			}
+			if cmd.Flags().Changed("raised-on") {
+				value, _ := cmd.Flags().GetString("raised-on")
+				raisedOn, err := budget.ParseDate(value)
+				if err != nil {
+					fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				attributes.RaisedOn = &raisedOn
+				changes = append(changes, fmt.Sprintf("raises from %s", raisedOn))
+			} else if attributes.RaisedOn == nil && attributes.Start == nil && attributes.Raise != 0 {
+				// Raises are counted from a recorded date, so that forecasts starting in different months agree
+				today := budget.Today()
+				attributes.RaisedOn = &today
+				changes = append(changes, fmt.Sprintf("raises from %s", today))
+			}
+		}
+
+		if cmd.Flags().Changed("inflation") {
+			if expense == nil {
+				fmt.Println(termenv.String("Only expenses inflate; use --raise for income sources").Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			value, _ := cmd.Flags().GetString("inflation")
+			if value == "none" {
+				expense.Inflation = nil
+				changes = append(changes, "the assumed rate of inflation")
+			} else {
+				inflation, err := quantity.NewPercentage(value)
+				if err != nil {
+					fmt.Println(termenv.String(fmt.Sprintf(`Invalid rate of inflation "%s"; expected a percentage, such as 2.5%%`, value)).Foreground(termenv.ANSIRed))
+					os.Exit(1)
+				}
+				expense.Inflation = &inflation
+				changes = append(changes, fmt.Sprintf("inflation of %s", inflation))
+			}
+		}
+
+		if len(changes) == 0 {
+			fmt.Println(termenv.String("Nothing to schedule; give --start, --end, --raise, --raise-every, --raised-on or --inflation").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if err := scheduleBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Scheduled "%s" with %s`, args[1], strings.Join(changes, ", "))).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scheduleCmd)
+
+	scheduleCmd.Flags().String("start", "", "The date the income source or expense starts (YYYY-MM-DD, or none)")
+	scheduleCmd.Flags().String("end", "", "The date the income source or expense ends (YYYY-MM-DD, or none)")
+	scheduleCmd.Flags().String("raise", "", "The raise the income source receives, such as 3%")
+	scheduleCmd.Flags().String("raise-every", "", "How often the income source receives a raise (default is every year)")
+	scheduleCmd.Flags().String("raised-on", "", "The date of a raise the income source received, which later raises follow from (YYYY-MM-DD)")
+	scheduleCmd.Flags().String("inflation", "", "The yearly rate of inflation of the expense, such as 2.5%, or none")
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "serves budgets over a local HTTP JSON API",
+	Long: `Serves the budgets saved in --dir over an HTTP JSON API listening on --addr, which is
+only reachable from this machine by default:
+
+  GET    /budgets                  lists the budgets with their monthly totals
+  GET    /budgets/{name}           gets a budget, as it is saved
+  POST   /budgets/{name}           creates a budget
+  PUT    /budgets/{name}           replaces a budget
+  DELETE /budgets/{name}           deletes a budget
+  GET    /budgets/{name}/report    reports on a budget, optionally ?period=weekly and so on
+
+Budgets are sent as they are saved, except that amounts may also be written as they are
+answered when creating budgets, such as "$1,200.50", "6%" or "$300/quarter", and are
+checked against the same bounds.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		addr := viper.GetString("serve_addr")
+		fmt.Println(termenv.String(fmt.Sprintf("Serving budgets in %s on http://%s", viper.GetString("serve_dir"), addr)).Foreground(termenv.ANSIGreen))
+		if err := http.ListenAndServe(addr, server.New(viper.GetString("serve_dir"))); err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().String("addr", "localhost:8080", "The address to listen on")
+	viper.BindPFlag("serve_addr", serveCmd.Flags().Lookup("addr"))
+
+	serveCmd.Flags().String("dir", ".", "The directory of the budgets to serve")
+	viper.BindPFlag("serve_dir", serveCmd.Flags().Lookup("dir"))
+}
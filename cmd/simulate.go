@@ -0,0 +1,65 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "simulates the risk of variable income and expenses",
+	Long: `Runs thousands of trials of a month of a budget, drawing the amounts of income sources
+and expenses that vary from how they were described with "budgetbuddy vary", and
+reports percentiles of the monthly surplus and the probability of a deficit. Trials
+are drawn from --seed, so the same seed always gives the same results.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		simulateBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		trials := viper.GetInt("simulation_trials")
+		if trials < 1 {
+			fmt.Println(termenv.String("The number of trials must be at least 1").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		simulation := simulateBudget.Simulate(trials, viper.GetInt64("simulation_seed"), runtime.NumCPU())
+		reports.ReportSimulation(simulateBudget, simulation)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+
+	simulateCmd.Flags().Int("trials", 10000, "The number of trials to run")
+	viper.BindPFlag("simulation_trials", simulateCmd.Flags().Lookup("trials"))
+
+	simulateCmd.Flags().Int64("seed", 1, "The seed the trials are drawn from")
+	viper.BindPFlag("simulation_seed", simulateCmd.Flags().Lookup("seed"))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/spf13/cobra"
+)
+
+// tagCmd represents the tag command
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "tags expenses with buckets and kinds",
+	Long: `Tags an expense of a budget with a bucket, such as needs, wants, savings or a
+bucket of your own, so it is graded by allocation rules in reports. Tagging an
+expense with "none" removes its bucket.
+
+Expenses may also be tagged with a kind using --kind, either housing or debt,
+and as fixed or discretionary costs using --fixed or --discretionary, so they
+are singled out by the financial health metrics of reports. The day of the month
+an expense is due may be given with --due, so calendars show when it is paid.`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		tagBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		expense, ok := tagBudget.Expenses[args[1]]
+		if !ok {
+			fmt.Println(termenv.String(fmt.Sprintf(`Budget "%s" has no expense named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		var tags []string
+		if len(args) == 3 {
+			expense.Bucket = budget.NormalizeBucket(args[2])
+			if expense.Bucket == "none" {
+				expense.Bucket = ""
+			}
+			tags = append(tags, fmt.Sprintf(`bucket "%s"`, args[2]))
+		}
+		if cmd.Flags().Changed("kind") {
+			kind, _ := cmd.Flags().GetString("kind")
+			switch kind = budget.NormalizeBucket(kind); kind {
+			case budget.HousingKind, budget.DebtKind:
+				expense.Kind = kind
+			case "none":
+				expense.Kind = ""
+			default:
+				fmt.Println(termenv.String(fmt.Sprintf(`Unknown kind "%s"; expected housing, debt or none`, kind)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			tags = append(tags, fmt.Sprintf(`kind "%s"`, kind))
+		}
+		if fixed, _ := cmd.Flags().GetBool("fixed"); fixed {
+			expense.Fixed = true
+			tags = append(tags, "fixed")
+		} else if discretionary, _ := cmd.Flags().GetBool("discretionary"); discretionary {
+			expense.Fixed = false
+			tags = append(tags, "discretionary")
+		}
+		if cmd.Flags().Changed("due") {
+			due, _ := cmd.Flags().GetInt("due")
+			if due < 0 || due > 31 {
+				fmt.Println(termenv.String(fmt.Sprintf("Invalid due day %d; expected a day of the month from 1 to 31, or 0 for none", due)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			expense.Due = due
+			tags = append(tags, fmt.Sprintf("due day %d", due))
+		}
+		if len(tags) == 0 {
+			fmt.Println(termenv.String("Nothing to tag; give a bucket, --kind, --fixed, --discretionary or --due").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if err := tagBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Tagged expense "%s" with %s`, args[1], strings.Join(tags, ", "))).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+
+	tagCmd.Flags().String("kind", "", "Tag the kind of expense singled out by health metrics (housing, debt or none)")
+	tagCmd.Flags().Bool("fixed", false, "Tag the expense as a fixed cost")
+	tagCmd.Flags().Bool("discretionary", false, "Tag the expense as a discretionary cost")
+	tagCmd.Flags().Int("due", 0, "Tag the day of the month the expense is due (1 to 31, or 0 for none)")
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "manages budget templates",
+	Long: `Manages templates of budgets, which new budgets can be created from with
+"budgetbuddy create --template". Templates are saved in the directory given by
+--templates, or the "templates" key of the config file. A few templates are shipped
+with budgetbuddy: single-renter, family-with-mortgage and student.`,
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/spf13/cobra"
+)
+
+// templateDeleteCmd represents the template delete command
+var templateDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "deletes a saved template",
+	Long:  `Deletes a saved template, by its name. Templates shipped with budgetbuddy cannot be deleted.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := budget.DeleteTemplate(args[0]); err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not delete template "%s": %s`, args[0], err)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Deleted template "%s"`, args[0])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateDeleteCmd)
+}
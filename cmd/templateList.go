@@ -0,0 +1,50 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+)
+
+// templateListCmd represents the template list command
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "lists templates",
+	Long:  `Lists the saved templates and those shipped with budgetbuddy.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		templates := make(map[string]*budget.Template)
+		for _, name := range budget.TemplateNames() {
+			template, err := budget.LoadTemplate(name)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Could not load template "%s": %s`, name, err)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			templates[name] = template
+		}
+		reports.ReportTemplates(templates)
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateListCmd)
+}
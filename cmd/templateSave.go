@@ -0,0 +1,57 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/spf13/cobra"
+)
+
+// templateSaveCmd represents the template save command
+var templateSaveCmd = &cobra.Command{
+	Use:   "save",
+	Short: "saves a budget as a template",
+	Long: `Saves the income sources and expenses of a budget as a named template. Savings,
+one-time events, scenarios, and when income sources and expenses start and end are
+left out. With --blank, amounts are also left out, so budgets created from the
+template prompt for them.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		templateBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		description, _ := cmd.Flags().GetString("description")
+		blank, _ := cmd.Flags().GetBool("blank")
+		if err := budget.SaveTemplate(args[1], budget.MakeTemplate(templateBudget, description, blank)); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`Saved template "%s"`, args[1])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateSaveCmd)
+
+	templateSaveCmd.Flags().String("description", "", "A description of the template")
+	templateSaveCmd.Flags().Bool("blank", false, "Leave amounts blank, to be filled in when a budget is created from the template")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/tui"
+	"github.com/spf13/cobra"
+)
+
+// tuiCmd represents the tui command
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "browses and edits budgets interactively",
+	Long: `Opens a full-screen interface on a budget, with panes for its income sources, expenses
+and summary. Move between amounts with the arrow keys, switch panes with tab and edit
+the selected amount with enter; amounts are checked as they are when creating budgets,
+and the totals are recalculated as they change. Save with s, and quit with q, which
+asks whether to save any unsaved changes.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tuiBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if err := tui.Run(args[0], tuiBudget); err != nil {
+			fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
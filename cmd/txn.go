@@ -0,0 +1,32 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// txnCmd represents the txn command
+var txnCmd = &cobra.Command{
+	Use:   "txn",
+	Short: "records actual transactions",
+	Long: `Records the transactions actually made against a budget. Transactions are stored
+in a journal alongside the budget, in "<budget>.journal".`,
+}
+
+func init() {
+	rootCmd.AddCommand(txnCmd)
+}
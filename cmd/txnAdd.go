@@ -0,0 +1,69 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/surveys"
+	"github.com/spf13/cobra"
+)
+
+// txnAddCmd represents the txn add command
+var txnAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "adds a transaction",
+	Long:  `Interactively prompts the user to record a transaction against a budget.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		txnBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		journal, err := budget.LoadJournal(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load journal "%s.journal"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		transaction, err := surveys.AskTransactionSurvey(txnBudget)
+		if err != nil {
+			switch err {
+			case terminal.InterruptErr:
+				fmt.Println(termenv.String("Aborted transaction entry").Foreground(termenv.ANSIRed))
+				os.Exit(0)
+			default:
+				panic(err)
+			}
+		}
+
+		journal.Add(transaction)
+		if err := journal.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf("Recorded transaction #%d", transaction.ID)).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	txnCmd.AddCommand(txnAddCmd)
+}
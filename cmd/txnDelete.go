@@ -0,0 +1,60 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/spf13/cobra"
+)
+
+// txnDeleteCmd represents the txn delete command
+var txnDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "deletes a transaction",
+	Long:  `Deletes a transaction, by its ID, from the journal of a budget.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Invalid transaction ID "%s"`, args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		journal, err := budget.LoadJournal(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load journal "%s.journal"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if err := journal.Delete(id); err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf("Could not delete transaction #%d", id)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		if err := journal.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf("Deleted transaction #%d", id)).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	txnCmd.AddCommand(txnDeleteCmd)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/reports"
+	"github.com/spf13/cobra"
+)
+
+// txnListCmd represents the txn list command
+var txnListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "lists transactions",
+	Long:  `Lists the transactions recorded against a budget, optionally for a single month.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		txnBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		journal, err := budget.LoadJournal(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load journal "%s.journal"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		transactions := journal.Transactions
+		if period, _ := cmd.Flags().GetString("period"); period != "" {
+			start, end, err := budget.MonthRange(period)
+			if err != nil {
+				fmt.Println(termenv.String(err.Error()).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			transactions = journal.Between(start, end)
+		}
+
+		reports.ReportTransactions("Transactions", transactions, txnBudget.ReportingCurrency())
+	},
+}
+
+func init() {
+	txnCmd.AddCommand(txnListCmd)
+
+	txnListCmd.Flags().String("period", "", "Only list transactions in the given month (YYYY-MM)")
+}
@@ -0,0 +1,124 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/spf13/cobra"
+)
+
+// varyCmd represents the vary command
+var varyCmd = &cobra.Command{
+	Use:   "vary",
+	Short: "describes how income and expenses vary",
+	Long: `Describes how the monthly amount of an income source or expense of a budget varies
+from month to month, for simulations. Amounts are those received or paid per month,
+in the currency of the income source or expense.
+
+Give --min, --likely and --max for a triangular distribution, such as a gig that
+earns $100 to $900 a month, most often $300. Give --mean and --stddev for a normal
+distribution, such as groceries of $400 a month, give or take $50. Give --fixed to
+stop the amount from varying.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		varyBudget, err := budget.Load(args[0])
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf(`Could not load budget "%s.budget"`, args[0])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		var varies **budget.Distribution
+		currency := varyBudget.ReportingCurrency()
+		if income, ok := varyBudget.Income[args[1]]; ok {
+			varies = &income.Attributes().Varies
+			currency = income.Attributes().Currency.Or(currency)
+		} else if expense, ok := varyBudget.Expenses[args[1]]; ok {
+			varies = &expense.Varies
+			currency = expense.Currency.Or(currency)
+		} else {
+			fmt.Println(termenv.String(fmt.Sprintf(`Budget "%s" has no income source or expense named "%s"`, args[0], args[1])).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		if fixed, _ := cmd.Flags().GetBool("fixed"); fixed {
+			*varies = nil
+			if err := varyBudget.Save(); err != nil {
+				panic(err)
+			}
+			fmt.Println(termenv.String(fmt.Sprintf(`"%s" no longer varies`, args[1])).Foreground(termenv.ANSIGreen))
+			return
+		}
+
+		amounts := make(map[string]quantity.Money)
+		for _, flag := range []string{"min", "likely", "max", "mean", "stddev"} {
+			if !cmd.Flags().Changed(flag) {
+				continue
+			}
+			value, _ := cmd.Flags().GetString(flag)
+			amount, err := parseBudgetMoney(value, currency)
+			if err != nil {
+				fmt.Println(termenv.String(fmt.Sprintf(`Invalid amount "%s" for --%s`, value, flag)).Foreground(termenv.ANSIRed))
+				os.Exit(1)
+			}
+			amounts[flag] = amount
+		}
+		given := func(flags ...string) bool {
+			for _, flag := range flags {
+				if _, ok := amounts[flag]; !ok {
+					return false
+				}
+			}
+			return len(amounts) == len(flags)
+		}
+
+		var distribution *budget.Distribution
+		switch {
+		case given("min", "likely", "max"):
+			distribution, err = budget.NewTriangularDistribution(amounts["min"], amounts["likely"], amounts["max"])
+		case given("mean", "stddev"):
+			distribution, err = budget.NewNormalDistribution(amounts["mean"], amounts["stddev"])
+		default:
+			fmt.Println(termenv.String("Give either --min, --likely and --max, or --mean and --stddev").Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+		if err != nil {
+			fmt.Println(termenv.String(fmt.Sprintf("Invalid distribution: %s", err)).Foreground(termenv.ANSIRed))
+			os.Exit(1)
+		}
+
+		*varies = distribution
+		if err := varyBudget.Save(); err != nil {
+			panic(err)
+		}
+		fmt.Println(termenv.String(fmt.Sprintf(`"%s" now varies`, args[1])).Foreground(termenv.ANSIGreen))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(varyCmd)
+
+	varyCmd.Flags().String("min", "", "The least amount per month")
+	varyCmd.Flags().String("likely", "", "The likeliest amount per month")
+	varyCmd.Flags().String("max", "", "The greatest amount per month")
+	varyCmd.Flags().String("mean", "", "The mean amount per month")
+	varyCmd.Flags().String("stddev", "", "The standard deviation of the amount per month")
+	varyCmd.Flags().Bool("fixed", false, "Stop the amount from varying")
+}
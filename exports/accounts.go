@@ -0,0 +1,56 @@
+package exports
+
+import (
+	"regexp"
+	"strings"
+)
+
+var accountComponentRegexp = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Accounts describes how income sources and expenses map to the accounts of a plain-text accounting journal
+type Accounts struct {
+	Income   string            // Parent account of income sources, such as "Income"
+	Expenses string            // Parent account of expenses, such as "Expenses"
+	Assets   string            // Account receiving income and paying expenses, such as "Assets:Checking"
+	Names    map[string]string // Accounts of specific income sources or expenses by name, overriding the parent accounts
+}
+
+// account returns the account of the named income source or expense
+func (accounts Accounts) account(parent string, name string) string {
+	for mappedName, account := range accounts.Names {
+		if strings.EqualFold(mappedName, name) {
+			return account
+		}
+	}
+	return parent + ":" + name
+}
+
+// IncomeAccount returns the account of the named income source
+func (accounts Accounts) IncomeAccount(name string) string {
+	return accounts.account(accounts.Income, name)
+}
+
+// ExpenseAccount returns the account of the named expense
+func (accounts Accounts) ExpenseAccount(name string) string {
+	return accounts.account(accounts.Expenses, name)
+}
+
+// beancountAccount rewrites an account so that it is valid in beancount, where each component
+// must start with a capital letter and contain only letters, numbers and dashes
+func beancountAccount(account string) string {
+	components := strings.Split(account, ":")
+	for index, component := range components {
+		words := accountComponentRegexp.Split(component, -1)
+		for wordIndex, word := range words {
+			if word != "" {
+				words[wordIndex] = strings.ToUpper(word[:1]) + word[1:]
+			}
+		}
+		component = strings.Trim(strings.Join(words, "-"), "-")
+		if component == "" || !(component[0] >= 'A' && component[0] <= 'Z') {
+			component = "X" + component
+		}
+		components[index] = component
+	}
+	return strings.Join(components, ":")
+}
@@ -0,0 +1,61 @@
+package exports
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// beancountAmount formats an amount for beancount
+func beancountAmount(money quantity.Money, currency quantity.Currency) string {
+	return strconv.FormatFloat(money.ValueOf(), 'f', currency.Decimals(), 64) + " " + currency.String()
+}
+
+// WriteBeancount writes a budget as monthly budget directives for beancount, in the custom "budget" form understood by fava.
+// As in beancount itself, income is negative.
+func WriteBeancount(writer io.Writer, name string, exportBudget *budget.Budget, accounts Accounts, start time.Time) error {
+	date := start.Format(budget.DateLayout)
+	currency := exportBudget.ReportingCurrency()
+	if _, err := fmt.Fprintf(writer, "; Budget %q exported by budgetbuddy\n\n", name); err != nil {
+		return err
+	}
+
+	type entry struct {
+		account string
+		amount  quantity.Money
+	}
+	entries := make([]entry, 0, len(exportBudget.Income)+len(exportBudget.Expenses))
+	for _, name := range exportBudget.Income.SortedNames() {
+		entries = append(entries, entry{beancountAccount(accounts.IncomeAccount(name)), -exportBudget.Income.Converted(name, currency)})
+	}
+	for _, name := range exportBudget.Expenses.SortedNames() {
+		entries = append(entries, entry{beancountAccount(accounts.ExpenseAccount(name)), exportBudget.Expenses.Converted(name, currency)})
+	}
+
+	opened := map[string]bool{beancountAccount(accounts.Assets): true}
+	if _, err := fmt.Fprintf(writer, "%s open %s\n", date, beancountAccount(accounts.Assets)); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !opened[entry.account] {
+			opened[entry.account] = true
+			if _, err := fmt.Fprintf(writer, "%s open %s\n", date, entry.account); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := fmt.Fprintln(writer); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if _, err := fmt.Fprintf(writer, "%s custom \"budget\" %s \"monthly\" %s\n", date, entry.account, beancountAmount(entry.amount, currency)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
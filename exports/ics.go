@@ -0,0 +1,188 @@
+package exports
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const (
+	icsDateLayout     = "20060102"         // Layout of dates in iCalendar
+	icsDateTimeLayout = "20060102T150405Z" // Layout of times in UTC in iCalendar
+	icsLineLength     = 75                 // Maximum length of a line in iCalendar, in octets, before it is folded
+)
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// icsWriter writes the content lines of an iCalendar file, folding long lines and stopping at the first error
+type icsWriter struct {
+	writer io.Writer
+	err    error
+}
+
+// line writes a content line, folding it at icsLineLength octets without splitting UTF-8 characters
+func (ics *icsWriter) line(name string, value string) {
+	if ics.err != nil {
+		return
+	}
+	line := name + ":" + value
+	var folded strings.Builder
+	length := 0
+	for _, character := range line {
+		size := len(string(character))
+		if length+size > icsLineLength {
+			folded.WriteString("\r\n ")
+			length = 1
+		}
+		folded.WriteRune(character)
+		length += size
+	}
+	folded.WriteString("\r\n")
+	_, ics.err = io.WriteString(ics.writer, folded.String())
+}
+
+// icsText escapes text for iCalendar
+func icsText(text string) string {
+	return icsTextEscaper.Replace(text)
+}
+
+// icsUID returns an identifier of an event that stays the same between exports, so calendar apps update events rather than duplicate them
+func icsUID(name string, kind string, eventName string) string {
+	identifier := accountComponentRegexp.ReplaceAllString(strings.ToLower(name+"-"+kind+"-"+eventName), "-")
+	return strings.Trim(identifier, "-") + "@budgetbuddy"
+}
+
+// icsMonthDays returns the BYMONTHDAY and BYSETPOS parts of a rule recurring on the given day of the month, or the last day of the
+// month if it is shorter. The last day is chosen by taking the last of the days from the 28th up to the given day.
+func icsMonthDays(day int) string {
+	if day <= 28 {
+		return "BYMONTHDAY=" + strconv.Itoa(day)
+	}
+	days := make([]string, 0, day-27)
+	for candidate := 28; candidate <= day; candidate++ {
+		days = append(days, strconv.Itoa(candidate))
+	}
+	return "BYMONTHDAY=" + strings.Join(days, ",") + ";BYSETPOS=-1"
+}
+
+// icsUntil returns the UNTIL part of a rule that ends with the given schedule, if it ends
+func icsUntil(schedule budget.Schedule) string {
+	if schedule.End == nil {
+		return ""
+	}
+	return ";UNTIL=" + schedule.End.Format(icsDateLayout)
+}
+
+// icsPayRule returns the recurrence rule of paychecks paid every given period from the given date
+func icsPayRule(paidEvery quantity.Period, paidOn time.Time) string {
+	interval := paidEvery.Count
+	switch paidEvery.Unit {
+	case quantity.DayUnit:
+		return fmt.Sprintf("FREQ=DAILY;INTERVAL=%d", interval)
+	case quantity.WeekUnit:
+		return fmt.Sprintf("FREQ=WEEKLY;INTERVAL=%d", interval)
+	case quantity.SemimonthUnit:
+		// Paid on the days of budget.SemimonthlyPaydays. Days after the 28th are paid on the last day of shorter months, as the
+		// largest of the candidate days from the 28th.
+		first, second := budget.SemimonthlyPaydays(paidOn)
+		if second <= 28 {
+			return fmt.Sprintf("FREQ=MONTHLY;BYMONTHDAY=%d,%d", first, second)
+		}
+		days := []string{strconv.Itoa(first)}
+		for candidate := 28; candidate <= second; candidate++ {
+			days = append(days, strconv.Itoa(candidate))
+		}
+		return "FREQ=MONTHLY;BYMONTHDAY=" + strings.Join(days, ",") + ";BYSETPOS=1,-1"
+	case quantity.QuarterUnit:
+		return fmt.Sprintf("FREQ=MONTHLY;INTERVAL=%d;%s", 3*interval, icsMonthDays(paidOn.Day()))
+	case quantity.YearUnit:
+		return fmt.Sprintf("FREQ=YEARLY;INTERVAL=%d;BYMONTH=%d;%s", interval, paidOn.Month(), icsMonthDays(paidOn.Day()))
+	default:
+		return fmt.Sprintf("FREQ=MONTHLY;INTERVAL=%d;%s", interval, icsMonthDays(paidOn.Day()))
+	}
+}
+
+// event writes an all-day recurring event, with an alarm the given number of days before each occurrence if reminding
+func (ics *icsWriter) event(uid string, start time.Time, rule string, summary string, description string, now time.Time, remindDays int) {
+	ics.line("BEGIN", "VEVENT")
+	ics.line("UID", uid)
+	ics.line("DTSTAMP", now.UTC().Format(icsDateTimeLayout))
+	ics.line("DTSTART;VALUE=DATE", start.Format(icsDateLayout))
+	ics.line("RRULE", rule)
+	ics.line("SUMMARY", icsText(summary))
+	ics.line("DESCRIPTION", icsText(description))
+	ics.line("TRANSP", "TRANSPARENT")
+	if remindDays > 0 {
+		ics.line("BEGIN", "VALARM")
+		ics.line("ACTION", "DISPLAY")
+		ics.line("DESCRIPTION", icsText(summary))
+		ics.line("TRIGGER", fmt.Sprintf("-P%dD", remindDays))
+		ics.line("END", "VALARM")
+	}
+	ics.line("END", "VEVENT")
+}
+
+// WriteICS writes the paydays of income sources and the due dates of expenses of a budget as recurring all-day events in an
+// iCalendar file (RFC 5545), with the amounts in their summaries. Paydays recur from their recorded pay date, and due dates
+// recur monthly from the month of the given start date, or from when the expense starts. Events stop when their income source
+// or expense ends. Income sources without a pay date and expenses without a due day are not written, since they have no schedule.
+// If remindDays is positive, each event has an alarm that many days before.
+func WriteICS(writer io.Writer, name string, exportBudget *budget.Budget, start time.Time, remindDays int) error {
+	currency := exportBudget.ReportingCurrency()
+	now := time.Now()
+	ics := &icsWriter{writer: writer}
+
+	ics.line("BEGIN", "VCALENDAR")
+	ics.line("VERSION", "2.0")
+	ics.line("PRODID", "-//budgetbuddy//budgetbuddy//EN")
+	ics.line("CALSCALE", "GREGORIAN")
+	ics.line("METHOD", "PUBLISH")
+	ics.line("X-WR-CALNAME", icsText(fmt.Sprintf("Budget %s", name)))
+
+	for _, incomeName := range exportBudget.Income.SortedNames() {
+		attributes := exportBudget.Income[incomeName].Attributes()
+		if attributes.PaidOn == nil {
+			continue
+		}
+		paidEvery := attributes.PayPeriod()
+		paycheck := budget.PerPeriod(exportBudget.Income.Converted(incomeName, currency), paidEvery)
+		ics.event(
+			icsUID(name, "payday", incomeName),
+			attributes.PaidOn.Time,
+			icsPayRule(paidEvery, attributes.PaidOn.Time)+icsUntil(attributes.Schedule),
+			fmt.Sprintf("Payday: %s (%s)", incomeName, paycheck.Format(currency)),
+			fmt.Sprintf("%s of income from %s is paid every %s, according to budget %s.", paycheck.Format(currency), incomeName, paidEvery, name),
+			now,
+			remindDays,
+		)
+	}
+
+	for _, expenseName := range exportBudget.Expenses.SortedNames() {
+		expense := exportBudget.Expenses[expenseName]
+		if expense.Due == 0 {
+			continue
+		}
+		amount := exportBudget.Expenses.Converted(expenseName, currency)
+		first := start
+		if expense.Start != nil && expense.Start.After(first) {
+			first = expense.Start.Time
+		}
+		ics.event(
+			icsUID(name, "due", expenseName),
+			expense.DueDates(first, first.AddDate(0, 1, 0))[0],
+			"FREQ=MONTHLY;"+icsMonthDays(expense.Due)+icsUntil(expense.Schedule),
+			fmt.Sprintf("Due: %s (%s)", expenseName, amount.Format(currency)),
+			fmt.Sprintf("%s for %s is due on day %d of each month, according to budget %s.", amount.Format(currency), expenseName, expense.Due, name),
+			now,
+			remindDays,
+		)
+	}
+
+	ics.line("END", "VCALENDAR")
+	return ics.err
+}
@@ -0,0 +1,55 @@
+package exports
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// ledgerAmount formats an amount for ledger and hledger
+func ledgerAmount(money quantity.Money, currency quantity.Currency) string {
+	return currency.Symbol() + strconv.FormatFloat(money.ValueOf(), 'f', currency.Decimals(), 64)
+}
+
+// writePeriodicTransaction writes a monthly periodic transaction, which both ledger and hledger use as a budget.
+// Amounts are converted into the currency of the budget.
+func writePeriodicTransaction(writer io.Writer, header string, exportBudget *budget.Budget, accounts Accounts) error {
+	currency := exportBudget.ReportingCurrency()
+	if _, err := fmt.Fprintf(writer, "%s\n", header); err != nil {
+		return err
+	}
+	for _, name := range exportBudget.Expenses.SortedNames() {
+		if _, err := fmt.Fprintf(writer, "    %-50s  %s\n", accounts.ExpenseAccount(name), ledgerAmount(exportBudget.Expenses.Converted(name, currency), currency)); err != nil {
+			return err
+		}
+	}
+	for _, name := range exportBudget.Income.SortedNames() {
+		if _, err := fmt.Fprintf(writer, "    %-50s  %s\n", accounts.IncomeAccount(name), ledgerAmount(-exportBudget.Income.Converted(name, currency), currency)); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(writer, "    %s\n", accounts.Assets); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteLedger writes a budget as a monthly periodic transaction for ledger, usable with --budget
+func WriteLedger(writer io.Writer, name string, exportBudget *budget.Budget, accounts Accounts, start time.Time) error {
+	if _, err := fmt.Fprintf(writer, "; Budget %q exported by budgetbuddy\n\n", name); err != nil {
+		return err
+	}
+	return writePeriodicTransaction(writer, fmt.Sprintf("~ Monthly from %s", start.Format("2006/01/02")), exportBudget, accounts)
+}
+
+// WriteHledger writes a budget as a monthly periodic transaction for hledger, usable with --budget
+func WriteHledger(writer io.Writer, name string, exportBudget *budget.Budget, accounts Accounts, start time.Time) error {
+	if _, err := fmt.Fprintf(writer, "; Budget %q exported by budgetbuddy\n\n", name); err != nil {
+		return err
+	}
+	return writePeriodicTransaction(writer, fmt.Sprintf("~ monthly from %s  %s", start.Format(budget.DateLayout), name), exportBudget, accounts)
+}
@@ -0,0 +1,139 @@
+package exports
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+)
+
+// odsMimeType is the MIME type of OpenDocument spreadsheets, which must be the first file of the archive, uncompressed
+const odsMimeType = "application/vnd.oasis.opendocument.spreadsheet"
+
+// odsReferenceRegexp matches references to cells and ranges in formulas, such as "B3", "$A$1:$B$2" and "Summary!$B$10"
+var odsReferenceRegexp = regexp.MustCompile(`(?:([A-Za-z]+)!)?(\$?[A-Z]{1,3}\$?[0-9]+)(?::(\$?[A-Z]{1,3}\$?[0-9]+))?`)
+
+// odsStyleNames are the names of the automatic styles of cells in ODS spreadsheets, by cellStyle
+var odsStyleNames = map[cellStyle]string{
+	headerStyle:  "header",
+	moneyStyle:   "money",
+	percentStyle: "percent",
+	totalStyle:   "total",
+}
+
+// odsStyles are the automatic styles of cells in ODS spreadsheets
+const odsStyles = `<number:number-style style:name="N-money"><number:number number:decimal-places="2" number:min-decimal-places="2" number:min-integer-digits="1" number:grouping="true"/></number:number-style>
+<number:percentage-style style:name="N-percent"><number:number number:decimal-places="2" number:min-decimal-places="2" number:min-integer-digits="1"/><number:text>%</number:text></number:percentage-style>
+<style:style style:name="header" style:family="table-cell"><style:text-properties fo:font-weight="bold"/></style:style>
+<style:style style:name="money" style:family="table-cell" style:data-style-name="N-money"/>
+<style:style style:name="percent" style:family="table-cell" style:data-style-name="N-percent"/>
+<style:style style:name="total" style:family="table-cell" style:data-style-name="N-money"><style:text-properties fo:font-weight="bold"/></style:style>
+`
+
+// odsFormula translates a formula from the A1 notation of Excel into OpenFormula, as in "of:=SUM([.B2:.B4])"
+func odsFormula(formula string) string {
+	translated := odsReferenceRegexp.ReplaceAllStringFunc(formula, func(reference string) string {
+		parts := odsReferenceRegexp.FindStringSubmatch(reference)
+		start := "." + parts[2]
+		if parts[1] != "" {
+			start = "$" + parts[1] + start
+		}
+		if parts[3] != "" {
+			return "[" + start + ":." + parts[3] + "]"
+		}
+		return "[" + start + "]"
+	})
+	return "of:=" + strings.ReplaceAll(translated, ",", ";")
+}
+
+// odsTable writes a sheet as the XML of an ODS table
+func odsTable(index int, sheet sheet) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, `<table:table table:name="%s">`, escapeXML(sheet.name))
+	for column := range sheet.widths {
+		fmt.Fprintf(&content, `<table:table-column table:style-name="co%d-%d"/>`, index, column)
+	}
+	for _, row := range sheet.rows {
+		content.WriteString(`<table:table-row>`)
+		for _, cell := range trimRow(row) {
+			attributes := ""
+			if name, styled := odsStyleNames[cell.style]; styled {
+				attributes += fmt.Sprintf(` table:style-name="%s"`, name)
+			}
+			if cell.formula != "" {
+				attributes += fmt.Sprintf(` table:formula="%s"`, escapeXML(odsFormula(cell.formula)))
+			}
+			switch value := cell.value.(type) {
+			case string:
+				fmt.Fprintf(&content, `<table:table-cell%s office:value-type="string"><text:p>%s</text:p></table:table-cell>`, attributes, escapeXML(value))
+			case float64:
+				valueType, display := "float", strconv.FormatFloat(value, 'f', -1, 64)
+				switch cell.style {
+				case percentStyle:
+					valueType, display = "percentage", strconv.FormatFloat(value*100, 'f', 2, 64)+"%"
+				case moneyStyle, totalStyle:
+					display = strconv.FormatFloat(value, 'f', 2, 64)
+				}
+				fmt.Fprintf(&content, `<table:table-cell%s office:value-type="%s" office:value="%s"><text:p>%s</text:p></table:table-cell>`,
+					attributes, valueType, strconv.FormatFloat(value, 'g', -1, 64), display)
+			default:
+				fmt.Fprintf(&content, `<table:table-cell%s/>`, attributes)
+			}
+		}
+		content.WriteString(`</table:table-row>`)
+	}
+	content.WriteString(`</table:table>`)
+	return content.String()
+}
+
+// WriteODS writes a budget as an OpenDocument spreadsheet, with sheets of its income sources, expenses and summary. Monthly amounts,
+// conversions and totals are formulas, which are recalculated whenever the spreadsheet is edited.
+func WriteODS(writer io.Writer, name string, exportBudget *budget.Budget) error {
+	sheets := budgetSheets(name, exportBudget)
+	archive := zip.NewWriter(writer)
+
+	// The MIME type must come first and be stored uncompressed, so that it can be detected at a fixed offset
+	mimeWriter, err := archive.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(mimeWriter, odsMimeType); err != nil {
+		return err
+	}
+
+	var content strings.Builder
+	content.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:number="urn:oasis:names:tc:opendocument:xmlns:datastyle:1.0" xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0" xmlns:of="urn:oasis:names:tc:opendocument:xmlns:of:1.2" office:version="1.2">
+<office:automatic-styles>
+`)
+	content.WriteString(odsStyles)
+	for index, sheet := range sheets {
+		for column, width := range sheet.widths {
+			// Columns are about 0.2 centimetres per character
+			fmt.Fprintf(&content, `<style:style style:name="co%d-%d" style:family="table-column"><style:table-column-properties style:column-width="%.2fcm"/></style:style>`+"\n", index, column, width*0.2)
+		}
+	}
+	content.WriteString(`</office:automatic-styles><office:body><office:spreadsheet>`)
+	for index, sheet := range sheets {
+		content.WriteString(odsTable(index, sheet))
+	}
+	content.WriteString(`</office:spreadsheet></office:body></office:document-content>`)
+
+	for _, file := range []zipFile{
+		{"META-INF/manifest.xml", `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+<manifest:file-entry manifest:full-path="/" manifest:media-type="` + odsMimeType + `" manifest:version="1.2"/>
+<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>`},
+		{"content.xml", content.String()},
+	} {
+		if err := writeZipFile(archive, file.name, file.content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
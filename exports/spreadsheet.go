@@ -0,0 +1,347 @@
+package exports
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// cellStyle identifies how a cell of a spreadsheet is formatted
+type cellStyle int
+
+const (
+	plainStyle   cellStyle = iota
+	headerStyle            // Bold text, for headers and labels
+	moneyStyle             // Amounts of money, with two decimals and thousands separators
+	percentStyle           // Percentages
+	totalStyle             // Bold amounts of money, for totals
+)
+
+// cell describes a cell of a spreadsheet. Formulas are written without a leading "=" in the A1 notation of Excel, with sheets
+// referenced as in "Summary!$B$10", and are translated for other formats. The value of a formula cell is its result, so that
+// spreadsheets show it before recalculating.
+type cell struct {
+	value   interface{} // A string, float64, or nil if the cell is empty
+	formula string
+	style   cellStyle
+}
+
+// sheet describes a sheet of a spreadsheet
+type sheet struct {
+	name   string
+	widths []float64 // Widths of the columns, in characters
+	rows   [][]cell
+}
+
+// text makes a cell of text
+func text(value string) cell {
+	return cell{value: value}
+}
+
+// header makes a cell of bold text
+func header(value string) cell {
+	return cell{value: value, style: headerStyle}
+}
+
+// finite returns a number, or nil if it is NaN or infinite, as amounts that could not be converted are, which spreadsheets cannot read
+func finite(value float64) interface{} {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+	return value
+}
+
+// number makes a cell of a number in the given style, which is empty if the number is unknown
+func number(value float64, style cellStyle) cell {
+	return cell{value: finite(value), style: style}
+}
+
+// formula makes a cell of a formula in the given style, with the result it evaluates to. If the result is unknown, the cell has no
+// result until the spreadsheet recalculates it.
+func formula(formula string, result float64, style cellStyle) cell {
+	return cell{value: finite(result), formula: formula, style: style}
+}
+
+// columnName returns the name of a column from its index, starting at 0, as in "A", "Z" and "AA"
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+// cellName returns the name of a cell from the indices of its column and row, starting at 0, as in "B3"
+func cellName(column int, row int) string {
+	return fmt.Sprintf("%s%d", columnName(column), row+1)
+}
+
+// sum makes a cell of the total of the rows of a column from first to last, or of nothing if there are no rows
+func sum(column int, first int, last int, result float64) cell {
+	if last < first {
+		return number(0, totalStyle)
+	}
+	return formula(fmt.Sprintf("SUM(%s:%s)", cellName(column, first), cellName(column, last)), result, totalStyle)
+}
+
+// Columns of the income sheet
+const (
+	incomeNameColumn = iota
+	incomeTypeColumn
+	incomeCurrencyColumn
+	incomeRateColumn
+	incomeHoursColumn
+	incomeItemsColumn
+	incomeSalaryColumn
+	incomeCommissionColumn
+	incomeVolumeColumn
+	incomeSupplementalColumn
+	incomeMonthlyColumn
+	incomeExchangeRateColumn
+	incomeConvertedColumn
+	incomeAnnualColumn
+	incomeVolumeItemsColumn // First of the columns of the items of commissions
+)
+
+// Columns of the expense sheet
+const (
+	expenseNameColumn = iota
+	expenseBucketColumn
+	expenseKindColumn
+	expenseFixedColumn
+	expenseCurrencyColumn
+	expenseMonthlyColumn
+	expenseExchangeRateColumn
+	expenseConvertedColumn
+	expenseAnnualColumn
+	expenseShareColumn
+)
+
+// Rows of the summary sheet
+const (
+	summaryBudgetRow = iota
+	summaryCurrencyRow
+	_
+	summaryHeaderRow
+	summaryIncomeRow
+	summaryExpensesRow
+	summaryRemainingRow
+	_
+	summaryAssumptionsRow
+	summaryNetPayRow
+	summaryOvertimeRow
+	_
+	summaryRatesHeaderRow
+	summaryRatesRow // First of the rows of exchange rates
+)
+
+// budgetSheets lays out a budget as sheets of its income sources, with the inputs of each type of income source, its expenses, and a
+// summary. Monthly amounts, conversions into the currency of the budget, and totals are formulas of those inputs, and of the
+// assumptions and exchange rates on the summary sheet, so the spreadsheet stays correct when they are edited.
+func budgetSheets(name string, exportBudget *budget.Budget) []sheet {
+	currency := exportBudget.ReportingCurrency()
+
+	// Gather the currencies of the budget, with the currency of the budget first
+	currencies := []quantity.Currency{currency}
+	seen := map[quantity.Currency]bool{currency: true}
+	addCurrency := func(other quantity.Currency) {
+		if other = other.Or(currency); !seen[other] {
+			seen[other] = true
+			currencies = append(currencies, other)
+		}
+	}
+	for _, incomeName := range exportBudget.Income.SortedNames() {
+		addCurrency(exportBudget.Income[incomeName].Attributes().Currency)
+	}
+	for _, expenseName := range exportBudget.Expenses.SortedNames() {
+		addCurrency(exportBudget.Expenses[expenseName].Currency)
+	}
+	sort.Slice(currencies[1:], func(i, j int) bool { return currencies[1+i] < currencies[1+j] })
+
+	netPay := "Summary!$B$" + fmt.Sprint(summaryNetPayRow+1)
+	overtime := "Summary!$B$" + fmt.Sprint(summaryOvertimeRow+1)
+	rates := fmt.Sprintf("Summary!$A$%d:$B$%d", summaryRatesRow+1, summaryRatesRow+len(currencies))
+	exchangeRate := func(currencyCell string, from quantity.Currency) cell {
+		return formula(fmt.Sprintf("VLOOKUP(%s,%s,2,0)", currencyCell, rates), budget.Convert(1, from, currency, time.Now()).ValueOf(), plainStyle)
+	}
+
+	// Lay out the income sources, one row each
+	incomeSheet := sheet{
+		name:   "Income",
+		widths: []float64{24, 14, 10, 14, 10, 10, 14, 12, 16, 14, 16, 14, 16, 16},
+		rows: [][]cell{{
+			header("Name"), header("Type"), header("Currency"), header("Rate"), header("Hours per Week"), header("Items per Month"),
+			header("Annual Salary"), header("Commission"), header("Volume"), header("Amount per Month"), header("Monthly"),
+			header("Exchange Rate"), header(fmt.Sprintf("Monthly (%s)", currency)), header(fmt.Sprintf("Annual (%s)", currency)),
+		}},
+	}
+	var volumeItems int
+	for _, incomeName := range exportBudget.Income.SortedNames() {
+		income := exportBudget.Income[incomeName]
+		incomeCurrency := income.Attributes().Currency.Or(currency)
+		row := len(incomeSheet.rows)
+		at := func(column int) string { return cellName(column, row) }
+
+		cells := make([]cell, incomeVolumeItemsColumn)
+		cells[incomeNameColumn] = text(incomeName)
+		cells[incomeCurrencyColumn] = text(string(incomeCurrency))
+		monthly := income.MonthlyIncome().ValueOf()
+		switch income := income.(type) {
+		case *budget.Wages:
+			cells[incomeTypeColumn] = text("Wages")
+			cells[incomeRateColumn] = number(income.Rate.ValueOf(), moneyStyle)
+			cells[incomeHoursColumn] = number(income.Hours.ValueOf(), plainStyle)
+			cells[incomeMonthlyColumn] = formula(fmt.Sprintf("%[1]s*(%[2]s*MIN(%[3]s,%[4]s)+1.5*%[2]s*MAX(%[3]s-%[4]s,0))*52/12",
+				netPay, at(incomeRateColumn), at(incomeHoursColumn), overtime), monthly, moneyStyle)
+		case *budget.Salary:
+			cells[incomeTypeColumn] = text("Salary")
+			cells[incomeSalaryColumn] = number(income.Salary.ValueOf(), moneyStyle)
+			cells[incomeMonthlyColumn] = formula(fmt.Sprintf("%s*%s/12", netPay, at(incomeSalaryColumn)), monthly, moneyStyle)
+		case *budget.Sales:
+			cells[incomeTypeColumn] = text("Sales")
+			cells[incomeRateColumn] = number(income.Rate.ValueOf(), moneyStyle)
+			cells[incomeItemsColumn] = number(income.Items.ValueOf(), plainStyle)
+			cells[incomeMonthlyColumn] = formula(fmt.Sprintf("%s*%s", at(incomeRateColumn), at(incomeItemsColumn)), monthly, moneyStyle)
+		case *budget.Commissions:
+			var volume float64
+			for _, item := range income.Volume {
+				cells = append(cells, number(item.ValueOf(), moneyStyle))
+				volume += item.ValueOf()
+			}
+			if len(income.Volume) > volumeItems {
+				volumeItems = len(income.Volume)
+			}
+			cells[incomeTypeColumn] = text("Commissions")
+			cells[incomeCommissionColumn] = number(income.Rate.ValueOf(), percentStyle)
+			if len(income.Volume) > 0 {
+				cells[incomeVolumeColumn] = formula(fmt.Sprintf("SUM(%s:%s)", at(incomeVolumeItemsColumn), at(incomeVolumeItemsColumn+len(income.Volume)-1)), volume, moneyStyle)
+			} else {
+				cells[incomeVolumeColumn] = number(0, moneyStyle)
+			}
+			cells[incomeMonthlyColumn] = formula(fmt.Sprintf("%s*%s", at(incomeCommissionColumn), at(incomeVolumeColumn)), monthly, moneyStyle)
+		case *budget.Supplemental:
+			cells[incomeTypeColumn] = text("Supplemental")
+			cells[incomeSupplementalColumn] = number(income.Money.ValueOf(), moneyStyle)
+			cells[incomeMonthlyColumn] = formula(at(incomeSupplementalColumn), monthly, moneyStyle)
+		default:
+			cells[incomeMonthlyColumn] = number(monthly, moneyStyle)
+		}
+		converted := exportBudget.Income.Converted(incomeName, currency).ValueOf()
+		cells[incomeExchangeRateColumn] = exchangeRate(at(incomeCurrencyColumn), incomeCurrency)
+		cells[incomeConvertedColumn] = formula(fmt.Sprintf("%s*%s", at(incomeMonthlyColumn), at(incomeExchangeRateColumn)), converted, moneyStyle)
+		cells[incomeAnnualColumn] = formula(fmt.Sprintf("%s*12", at(incomeConvertedColumn)), converted*12, moneyStyle)
+		incomeSheet.rows = append(incomeSheet.rows, cells)
+	}
+	for item := 0; item < volumeItems; item++ {
+		incomeSheet.rows[0] = append(incomeSheet.rows[0], header(fmt.Sprintf("Item %d", item+1)))
+		incomeSheet.widths = append(incomeSheet.widths, 14)
+	}
+	incomeTotal := exportBudget.Income.Sum(currency).ValueOf()
+	incomeTotalRow := len(incomeSheet.rows)
+	incomeSheet.rows = append(incomeSheet.rows, make([]cell, incomeVolumeItemsColumn))
+	incomeSheet.rows[incomeTotalRow][incomeNameColumn] = header("Total")
+	incomeSheet.rows[incomeTotalRow][incomeConvertedColumn] = sum(incomeConvertedColumn, 1, incomeTotalRow-1, incomeTotal)
+	incomeSheet.rows[incomeTotalRow][incomeAnnualColumn] = sum(incomeAnnualColumn, 1, incomeTotalRow-1, incomeTotal*12)
+	incomeTotalCell := fmt.Sprintf("Income!$%s$%d", columnName(incomeConvertedColumn), incomeTotalRow+1)
+
+	// Lay out the expenses, one row each
+	expenseSheet := sheet{
+		name:   "Expenses",
+		widths: []float64{24, 12, 12, 8, 10, 14, 14, 16, 16, 16},
+		rows: [][]cell{{
+			header("Name"), header("Bucket"), header("Kind"), header("Fixed"), header("Currency"), header("Monthly"), header("Exchange Rate"),
+			header(fmt.Sprintf("Monthly (%s)", currency)), header(fmt.Sprintf("Annual (%s)", currency)), header("Share of Income"),
+		}},
+	}
+	for _, expenseName := range exportBudget.Expenses.SortedNames() {
+		expense := exportBudget.Expenses[expenseName]
+		expenseCurrency := expense.Currency.Or(currency)
+		row := len(expenseSheet.rows)
+		at := func(column int) string { return cellName(column, row) }
+
+		fixed := "No"
+		if expense.Fixed {
+			fixed = "Yes"
+		}
+		converted := exportBudget.Expenses.Converted(expenseName, currency).ValueOf()
+		var share float64
+		if incomeTotal != 0 {
+			share = converted / incomeTotal
+		}
+		expenseSheet.rows = append(expenseSheet.rows, []cell{
+			text(expenseName),
+			text(expense.Bucket),
+			text(expense.Kind),
+			text(fixed),
+			text(string(expenseCurrency)),
+			number(expense.MonthlyExpense().ValueOf(), moneyStyle),
+			exchangeRate(at(expenseCurrencyColumn), expenseCurrency),
+			formula(fmt.Sprintf("%s*%s", at(expenseMonthlyColumn), at(expenseExchangeRateColumn)), converted, moneyStyle),
+			formula(fmt.Sprintf("%s*12", at(expenseConvertedColumn)), converted*12, moneyStyle),
+			formula(fmt.Sprintf("IF(%[2]s=0,0,%[1]s/%[2]s)", at(expenseConvertedColumn), incomeTotalCell), share, percentStyle),
+		})
+	}
+	expenseTotal := exportBudget.Expenses.Sum(currency).ValueOf()
+	expenseTotalRow := len(expenseSheet.rows)
+	var expenseShare float64
+	if incomeTotal != 0 {
+		expenseShare = expenseTotal / incomeTotal
+	}
+	expenseSheet.rows = append(expenseSheet.rows, []cell{
+		header("Total"), {}, {}, {}, {}, {}, {},
+		sum(expenseConvertedColumn, 1, expenseTotalRow-1, expenseTotal),
+		sum(expenseAnnualColumn, 1, expenseTotalRow-1, expenseTotal*12),
+		formula(fmt.Sprintf("IF(%[2]s=0,0,%[1]s/%[2]s)", cellName(expenseConvertedColumn, expenseTotalRow), incomeTotalCell), expenseShare, percentStyle),
+	})
+	expenseTotalCell := fmt.Sprintf("Expenses!$%s$%d", columnName(expenseConvertedColumn), expenseTotalRow+1)
+
+	// Summarise the totals per week, month and year, followed by the assumptions and exchange rates the other sheets use
+	summarySheet := sheet{
+		name:   "Summary",
+		widths: []float64{30, 16, 16, 16},
+		rows:   make([][]cell, summaryRatesRow, summaryRatesRow+len(currencies)),
+	}
+	summaryRow := func(label string, monthlyFormula string, monthly float64, style cellStyle, row int) []cell {
+		perMonth := cellName(2, row)
+		return []cell{
+			header(label),
+			formula(fmt.Sprintf("%s*12/52", perMonth), budget.PerPeriod(quantity.Money(monthly), quantity.Week).ValueOf(), style),
+			formula(monthlyFormula, monthly, style),
+			formula(fmt.Sprintf("%s*12", perMonth), monthly*12, style),
+		}
+	}
+	remaining := exportBudget.Sum().ValueOf()
+	summarySheet.rows[summaryBudgetRow] = []cell{header("Budget"), text(name)}
+	summarySheet.rows[summaryCurrencyRow] = []cell{header("Currency"), text(string(currency))}
+	summarySheet.rows[summaryHeaderRow] = []cell{{}, header("Per Week"), header("Per Month"), header("Per Year")}
+	summarySheet.rows[summaryIncomeRow] = summaryRow("Income", incomeTotalCell, incomeTotal, moneyStyle, summaryIncomeRow)
+	summarySheet.rows[summaryExpensesRow] = summaryRow("Expenses", expenseTotalCell, expenseTotal, moneyStyle, summaryExpensesRow)
+	summarySheet.rows[summaryRemainingRow] = summaryRow("Remaining",
+		fmt.Sprintf("%s-%s", cellName(2, summaryIncomeRow), cellName(2, summaryExpensesRow)), remaining, totalStyle, summaryRemainingRow)
+	summarySheet.rows[summaryAssumptionsRow] = []cell{header("Assumptions")}
+	summarySheet.rows[summaryNetPayRow] = []cell{text("Net Pay Percentage"), number(budget.NetPayPercentage, percentStyle)}
+	summarySheet.rows[summaryOvertimeRow] = []cell{text("Overtime After (Hours per Week)"), number(budget.MinimumOvertimeHours, plainStyle)}
+	summarySheet.rows[summaryRatesHeaderRow] = []cell{header("Currency"), header(fmt.Sprintf("Exchange Rate to %s", currency))}
+	for _, rateCurrency := range currencies {
+		// Missing exchange rates are left as #N/A, so that the amounts converted with them are #N/A rather than silently zero
+		rate := number(budget.Convert(1, rateCurrency, currency, time.Now()).ValueOf(), plainStyle)
+		if rate.value == nil {
+			rate = formula("NA()", math.NaN(), plainStyle)
+		}
+		summarySheet.rows = append(summarySheet.rows, []cell{text(string(rateCurrency)), rate})
+	}
+
+	return []sheet{incomeSheet, expenseSheet, summarySheet}
+}
+
+// trimRow drops the empty cells at the end of a row
+func trimRow(row []cell) []cell {
+	for len(row) > 0 && row[len(row)-1].value == nil && row[len(row)-1].formula == "" {
+		row = row[:len(row)-1]
+	}
+	return row
+}
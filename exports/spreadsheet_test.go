@@ -0,0 +1,109 @@
+package exports
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestBudgetSheets(t *testing.T) {
+	defer func(rates *quantity.ExchangeRates, netPayPercentage, minimumOvertimeHours float64) {
+		budget.ExchangeRates, budget.NetPayPercentage, budget.MinimumOvertimeHours = rates, netPayPercentage, minimumOvertimeHours
+	}(budget.ExchangeRates, budget.NetPayPercentage, budget.MinimumOvertimeHours)
+	budget.ExchangeRates = quantity.NewExchangeRates(quantity.ExchangeRate{Date: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), From: "EUR", To: "USD", Rate: 1.1})
+	budget.NetPayPercentage, budget.MinimumOvertimeHours = 0.75, 40
+
+	exportBudget := budget.Make("test")
+	exportBudget.Currency = "USD"
+	exportBudget.Income["job"] = &budget.Wages{Rate: 20, Hours: 45}
+	exportBudget.Income["sales"] = &budget.Commissions{Rate: 0.1, Volume: []quantity.Money{1000, 2000}}
+	exportBudget.Expenses["flat"] = &budget.Expense{Amount: 500, Currency: "EUR"}
+	exportBudget.Expenses["rent"] = &budget.Expense{Amount: 1000}
+	exportBudget.Expenses["ski pass"] = &budget.Expense{Amount: 300, Currency: "CHF"}
+
+	// Wages are paid 40 hours at the rate and 5 hours of overtime at 1.5 times the rate, 52 weeks a year, after net pay
+	wages := 0.75 * (20*40 + 1.5*20*5) * 52 / 12
+	income := wages + 0.1*3000
+	sheets := budgetSheets("test", exportBudget)
+	for _, test := range []struct {
+		sheet   int
+		cell    string
+		formula string
+		result  interface{} // Cached result, or nil if unknown
+	}{
+		{0, "K2", "Summary!$B$10*(D2*MIN(E2,Summary!$B$11)+1.5*D2*MAX(E2-Summary!$B$11,0))*52/12", wages},
+		{0, "L2", "VLOOKUP(C2,Summary!$A$14:$B$16,2,0)", 1.0},
+		{0, "M2", "K2*L2", wages},
+		{0, "N2", "M2*12", wages * 12},
+		{0, "I3", "SUM(O3:P3)", 3000.0},
+		{0, "K3", "H3*I3", 300.0},
+		{0, "M4", "SUM(M2:M3)", income},
+		{1, "G2", "VLOOKUP(E2,Summary!$A$14:$B$16,2,0)", 1.1},
+		{1, "H2", "F2*G2", 550.0},
+		{1, "J2", "IF(Income!$M$4=0,0,H2/Income!$M$4)", 550 / income},
+		{1, "H3", "F3*G3", 1000.0},
+		{1, "G4", "VLOOKUP(E4,Summary!$A$14:$B$16,2,0)", nil},
+		{1, "H4", "F4*G4", nil},
+		{1, "I4", "H4*12", nil},
+		{1, "H5", "SUM(H2:H4)", nil},
+		{2, "B5", "C5*12/52", income * 12 / 52},
+		{2, "C5", "Income!$M$4", income},
+		{2, "D5", "C5*12", income * 12},
+		{2, "C6", "Expenses!$H$5", nil},
+		{2, "B10", "", 0.75},
+		{2, "B11", "", 40.0},
+		{2, "B14", "", 1.0},
+		{2, "B15", "NA()", nil},
+		{2, "B16", "", 1.1},
+	} {
+		got := cellAt(sheets[test.sheet], test.cell)
+		if got.formula != test.formula {
+			t.Errorf("%s!%s: got formula %q, want %q", sheets[test.sheet].name, test.cell, got.formula, test.formula)
+		}
+		switch want := test.result.(type) {
+		case nil:
+			if got.value != nil {
+				t.Errorf("%s!%s: got result %v, want none", sheets[test.sheet].name, test.cell, got.value)
+			}
+		case float64:
+			if value, ok := got.value.(float64); !ok || math.Abs(value-want) > 1e-9 {
+				t.Errorf("%s!%s: got result %v, want %v", sheets[test.sheet].name, test.cell, got.value, want)
+			}
+		}
+	}
+
+	for row, want := range []string{"USD", "CHF", "EUR"} {
+		if got := cellAt(sheets[2], cellName(0, summaryRatesRow+row)); got.value != want {
+			t.Errorf("exchange rate %d: got %v, want %s", row+1, got.value, want)
+		}
+	}
+}
+
+// cellAt returns the named cell of a sheet, or an empty cell if the sheet has none there
+func cellAt(sheet sheet, name string) cell {
+	for row := range sheet.rows {
+		for column := range sheet.rows[row] {
+			if cellName(column, row) == name {
+				return sheet.rows[row][column]
+			}
+		}
+	}
+	return cell{}
+}
+
+func TestODSFormula(t *testing.T) {
+	for formula, want := range map[string]string{
+		"K2*L2":                               "of:=[.K2]*[.L2]",
+		"SUM(M2:M3)":                          "of:=SUM([.M2:.M3])",
+		"VLOOKUP(C2,Summary!$A$14:$B$16,2,0)": "of:=VLOOKUP([.C2];[$Summary.$A$14:.$B$16];2;0)",
+		"IF(Income!$M$4=0,0,H2/Income!$M$4)":  "of:=IF([$Income.$M$4]=0;0;[.H2]/[$Income.$M$4])",
+		"NA()":                                "of:=NA()",
+	} {
+		if got := odsFormula(formula); got != want {
+			t.Errorf("%s: got %q, want %q", formula, got, want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package exports
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+)
+
+// xlsxStyles are the styles of XLSX workbooks. The index of each cell format is its cellStyle.
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="5">
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>
+<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>
+<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>
+<xf numFmtId="10" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>
+<xf numFmtId="4" fontId="1" fillId="0" borderId="0" xfId="0" applyNumberFormat="1" applyFont="1"/>
+</cellXfs>
+<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>
+</styleSheet>
+`
+
+// escapeXML escapes text for XML
+func escapeXML(text string) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
+
+// zipFile is a file to write into a zip archive
+type zipFile struct {
+	name    string
+	content string
+}
+
+// writeZipFile writes a file into a zip archive
+func writeZipFile(archive *zip.Writer, name string, content string) error {
+	fileWriter, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(fileWriter, content)
+	return err
+}
+
+// xlsxSheet writes a sheet as the XML of an XLSX worksheet
+func xlsxSheet(sheet sheet) string {
+	var content strings.Builder
+	content.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	content.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	content.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	content.WriteString(`<cols>`)
+	for index, width := range sheet.widths {
+		fmt.Fprintf(&content, `<col min="%[1]d" max="%[1]d" width="%.1f" customWidth="1"/>`, index+1, width)
+	}
+	content.WriteString(`</cols><sheetData>`)
+	for rowIndex, row := range sheet.rows {
+		fmt.Fprintf(&content, `<row r="%d">`, rowIndex+1)
+		for columnIndex, cell := range trimRow(row) {
+			reference := cellName(columnIndex, rowIndex)
+			switch value := cell.value.(type) {
+			case string:
+				fmt.Fprintf(&content, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, reference, cell.style, escapeXML(value))
+			case float64:
+				fmt.Fprintf(&content, `<c r="%s" s="%d">`, reference, cell.style)
+				if cell.formula != "" {
+					fmt.Fprintf(&content, `<f>%s</f>`, escapeXML(cell.formula))
+				}
+				fmt.Fprintf(&content, `<v>%s</v></c>`, strconv.FormatFloat(value, 'g', -1, 64))
+			default:
+				if cell.formula != "" {
+					// Formulas whose result is unknown are written without one, and calculated when the workbook is opened
+					fmt.Fprintf(&content, `<c r="%s" s="%d"><f>%s</f></c>`, reference, cell.style, escapeXML(cell.formula))
+				} else if cell.style != plainStyle {
+					fmt.Fprintf(&content, `<c r="%s" s="%d"/>`, reference, cell.style)
+				}
+			}
+		}
+		content.WriteString(`</row>`)
+	}
+	content.WriteString(`</sheetData></worksheet>`)
+	return content.String()
+}
+
+// WriteXLSX writes a budget as an Office Open XML workbook, with sheets of its income sources, expenses and summary. Monthly amounts,
+// conversions and totals are formulas, which are recalculated when the workbook is opened and whenever it is edited.
+func WriteXLSX(writer io.Writer, name string, exportBudget *budget.Budget) error {
+	sheets := budgetSheets(name, exportBudget)
+	archive := zip.NewWriter(writer)
+
+	var contentTypes, workbook, relationships strings.Builder
+	contentTypes.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+`)
+	workbook.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	relationships.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rIdStyles" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+`)
+	for index, sheet := range sheets {
+		fmt.Fprintf(&contentTypes, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`+"\n", index+1)
+		fmt.Fprintf(&workbook, `<sheet name="%s" sheetId="%[2]d" r:id="rId%[2]d"/>`, escapeXML(sheet.name), index+1)
+		fmt.Fprintf(&relationships, `<Relationship Id="rId%[1]d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%[1]d.xml"/>`+"\n", index+1)
+	}
+	contentTypes.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets><calcPr calcId="0" fullCalcOnLoad="1"/></workbook>`)
+	relationships.WriteString(`</Relationships>`)
+
+	files := []zipFile{
+		{"[Content_Types].xml", contentTypes.String()},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`},
+		{"xl/workbook.xml", workbook.String()},
+		{"xl/_rels/workbook.xml.rels", relationships.String()},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for index, sheet := range sheets {
+		files = append(files, zipFile{fmt.Sprintf("xl/worksheets/sheet%d.xml", index+1), xlsxSheet(sheet)})
+	}
+	for _, file := range files {
+		if err := writeZipFile(archive, file.name, file.content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
@@ -10,5 +10,6 @@ require (
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/spf13/cobra v1.2.1
 	github.com/spf13/viper v1.8.1
+	golang.org/x/term v0.0.0-20210503060354-a79de5458b56
 	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
 )
@@ -0,0 +1,116 @@
+package reports
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// ReportAllocation grades how the expenses of a budget are allocated between buckets against an allocation rule, such as 50/30/20,
+// as shares of net income, with amounts rescaled to the given period. Income left unallocated counts as savings when the rule has a savings bucket, and is shown separately otherwise.
+// Buckets that are not part of the rule are shown without targets.
+func ReportAllocation(reportBudget *budget.Budget, rule *budget.AllocationRule, period quantity.Period) {
+	currency := reportBudget.ReportingCurrency()
+	income := budget.PerPeriod(reportBudget.Income.Sum(currency), period)
+	actuals := reportBudget.Expenses.Buckets(currency)
+	for bucket, actual := range actuals {
+		actuals[bucket] = budget.PerPeriod(actual, period)
+	}
+
+	remaining := budget.PerPeriod(reportBudget.Sum(), period)
+	_, savesRemaining := rule.Shares[budget.SavingsBucket]
+	savesRemaining = savesRemaining && remaining > 0
+	if savesRemaining {
+		actuals[budget.SavingsBucket] += remaining
+	}
+
+	tableWriter := table.NewWriter()
+
+	columnConfigs := []table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    40,
+		},
+	}
+	for number := 2; number <= 7; number++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{
+			Number:      number,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+		})
+	}
+	tableWriter.SetColumnConfigs(columnConfigs)
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(periodTitle(fmt.Sprintf("Allocation by the %s Rule", rule.Name), period))
+	tableWriter.AppendHeader(table.Row{"Bucket", "Target %", "Target", "Planned", "Planned %", "Difference", ""})
+
+	share := func(money quantity.Money) quantity.Percentage {
+		return quantity.Percentage(money.ValueOf() / income.ValueOf())
+	}
+
+	var overBuckets []string
+	for _, bucket := range rule.Buckets() {
+		target := quantity.Money(income.ValueOf() * rule.Shares[bucket].ValueOf())
+		row := variance{planned: target, actual: actuals[bucket]}
+		isExpense := bucket != budget.SavingsBucket
+		tableWriter.AppendRow(table.Row{
+			strings.Title(bucket),
+			rule.Shares[bucket],
+			target.Format(currency),
+			row.actual.Format(currency),
+			share(row.actual),
+			row.difference().Format(currency),
+			row.flag(isExpense),
+		})
+		if isExpense && row.difference() > 0 {
+			overBuckets = append(overBuckets, fmt.Sprintf("%s by %s", bucket, row.difference().Format(currency)))
+		}
+		delete(actuals, bucket)
+	}
+
+	// Buckets outside of the rule have no target
+	untagged, hasUntagged := actuals[""]
+	delete(actuals, "")
+	for _, bucket := range sortedBuckets(actuals) {
+		tableWriter.AppendRow(table.Row{strings.Title(bucket), "", "", actuals[bucket].Format(currency), share(actuals[bucket]), "", ""})
+	}
+	if hasUntagged {
+		tableWriter.AppendRow(table.Row{"(Untagged)", "", "", untagged.Format(currency), share(untagged), "", ""})
+	}
+	if !savesRemaining {
+		tableWriter.AppendRow(table.Row{"(Unallocated)", "", "", remaining.Format(currency), share(remaining), "", ""})
+	}
+
+	tableWriter.AppendFooter(table.Row{"Net Income", quantity.Percentage(1), income.Format(currency), income.Format(currency), quantity.Percentage(1), "", ""})
+
+	fmt.Println(tableWriter.Render())
+
+	if len(overBuckets) > 0 {
+		fmt.Println(text.FgHiRed.Sprintf("Over target: %s.", strings.Join(overBuckets, "; ")))
+	}
+	if savesRemaining {
+		fmt.Println(text.Faint.Sprintf("Savings include %s of income left unallocated.", remaining.Format(currency)))
+	}
+	if hasUntagged {
+		fmt.Println(text.Faint.Sprint("Tag untagged expenses with \"budgetbuddy tag\" to include them in the buckets of the rule."))
+	}
+}
+
+// sortedBuckets sorts the names of buckets lexographically
+func sortedBuckets(buckets map[string]quantity.Money) []string {
+	names := make([]string, 0, len(buckets))
+	for name := range buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,222 @@
+package reports
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const calendarMonthWidth = 20 // Width of a month of a calendar, as seven days of two columns separated by spaces
+
+// calendarDay describes what happens on a day of a cash-flow calendar
+type calendarDay struct {
+	inflow   bool           // Whether money is received
+	outflow  bool           // Whether money is paid
+	balance  quantity.Money // Projected balance at the end of the day
+	inWindow bool           // Whether the day is within the projection
+}
+
+// calendarMonth writes a month of a cash-flow calendar as lines of calendarMonthWidth columns, with days colored by what happens on them
+func calendarMonth(month time.Time, days map[time.Time]calendarDay, threshold quantity.Money) []string {
+	title := month.Format("January 2006")
+	padding := (calendarMonthWidth - len(title)) / 2
+	lines := []string{
+		text.Bold.Sprint(fmt.Sprintf("%*s%s%*s", padding, "", title, calendarMonthWidth-padding-len(title), "")),
+		text.Faint.Sprint("Mo Tu We Th Fr Sa Su"),
+	}
+
+	// Weeks start on Monday
+	offset := (int(month.Weekday()) + 6) % 7
+	cells := make([]string, offset, 42)
+	for index := range cells {
+		cells[index] = "  "
+	}
+	for date := month; date.Month() == month.Month(); date = date.AddDate(0, 0, 1) {
+		cell := fmt.Sprintf("%2d", date.Day())
+		day := days[date]
+		switch {
+		case !day.inWindow:
+			cell = text.Faint.Sprint(cell)
+		case day.balance < threshold:
+			cell = text.Colors{text.BgRed, text.FgHiWhite, text.Bold}.Sprint(cell)
+		case day.inflow:
+			cell = text.Colors{text.FgHiGreen, text.Bold}.Sprint(cell)
+		case day.outflow:
+			cell = text.FgHiYellow.Sprint(cell)
+		}
+		cells = append(cells, cell)
+	}
+	for len(cells)%7 != 0 {
+		cells = append(cells, "  ")
+	}
+	for week := 0; week < len(cells); week += 7 {
+		lines = append(lines, strings.Join(cells[week:week+7], " "))
+	}
+	for len(lines) < 8 {
+		lines = append(lines, strings.Repeat(" ", calendarMonthWidth))
+	}
+	return lines
+}
+
+// reportCalendarMonths draws the months of a cash-flow calendar side by side, as many as fit the terminal
+func reportCalendarMonths(start, end time.Time, flows []budget.CashFlow, balance, threshold quantity.Money) {
+	days := make(map[time.Time]calendarDay)
+	index := 0
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		day := calendarDay{inWindow: true}
+		for ; index < len(flows) && flows[index].Date.Equal(date); index++ {
+			day.inflow = day.inflow || flows[index].Amount > 0
+			day.outflow = day.outflow || flows[index].Amount < 0
+			balance = flows[index].Balance
+		}
+		day.balance = balance
+		days[date] = day
+	}
+
+	var months [][]string
+	for month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC); month.Before(end); month = month.AddDate(0, 1, 0) {
+		months = append(months, calendarMonth(month, days, threshold))
+	}
+
+	perRow := (newChartCanvas().width + 3) / (calendarMonthWidth + 3)
+	if perRow < 1 {
+		perRow = 1
+	}
+	for first := 0; first < len(months); first += perRow {
+		last := first + perRow
+		if last > len(months) {
+			last = len(months)
+		}
+		for line := range months[first] {
+			parts := make([]string, 0, last-first)
+			for _, month := range months[first:last] {
+				parts = append(parts, month[line])
+			}
+			fmt.Println(strings.TrimRight(strings.Join(parts, "   "), " "))
+		}
+	}
+	fmt.Println(strings.Join([]string{
+		text.Colors{text.FgHiGreen, text.Bold}.Sprint("paid"),
+		text.FgHiYellow.Sprint("due"),
+		text.Colors{text.BgRed, text.FgHiWhite, text.Bold}.Sprint("low balance"),
+	}, "  "))
+}
+
+// ReportCalendar projects the cash flow of a budget day by day within [start, end) from a starting balance, drawing a calendar
+// and a timeline of the money received and paid, and warns about each stretch of days the balance is projected to be below the threshold.
+func ReportCalendar(reportBudget *budget.Budget, start, end time.Time, balance, threshold quantity.Money) {
+	currency := reportBudget.ReportingCurrency()
+	flows := reportBudget.CashFlows(start, end, balance)
+
+	reportCalendarMonths(start, end, flows, balance, threshold)
+	fmt.Println()
+
+	tableWriter := table.NewWriter()
+
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    40,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      5,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(fmt.Sprintf("Cash Flow from %s to %s", start.Format(budget.DateLayout), end.AddDate(0, 0, -1).Format(budget.DateLayout)))
+	tableWriter.AppendHeader(table.Row{"Date", "Name", "Inflow", "Outflow", "Balance"})
+	tableWriter.AppendRow(table.Row{start.Format(budget.DateLayout), "(Starting Balance)", "", "", balance.Format(currency)})
+
+	var assumed bool
+	for _, flow := range flows {
+		name := flow.Name
+		if flow.Assumed {
+			name += "*"
+			assumed = true
+		}
+		var inflow, outflow string
+		if flow.Amount >= 0 {
+			inflow = flow.Amount.Format(currency)
+		} else {
+			outflow = (-flow.Amount).Format(currency)
+		}
+		balanceCell := flow.Balance.Format(currency)
+		if flow.Balance < threshold {
+			balanceCell = text.Colors{text.FgHiRed, text.Bold}.Sprint(balanceCell)
+		}
+		tableWriter.AppendRow(table.Row{flow.Date.Format(budget.DateLayout), name, inflow, outflow, balanceCell})
+	}
+
+	fmt.Println(tableWriter.Render())
+	if assumed {
+		fmt.Println(text.Faint.Sprint("* Date assumed: record due days with \"budgetbuddy tag --due\" and pay dates with \"budgetbuddy payday\"."))
+	}
+
+	reportLowBalances(start, end, flows, balance, threshold, currency)
+}
+
+// reportLowBalances warns about each stretch of days the projected balance is below the threshold
+func reportLowBalances(start, end time.Time, flows []budget.CashFlow, balance, threshold quantity.Money, currency quantity.Currency) {
+	var warnings []string
+	var low bool
+	var lowStart, lowestDate time.Time
+	var lowest quantity.Money
+	warn := func(lowEnd time.Time) {
+		warnings = append(warnings, fmt.Sprintf(
+			"From %s to %s, the balance is projected to be below %s, as low as %s on %s.",
+			lowStart.Format(budget.DateLayout), lowEnd.Format(budget.DateLayout), threshold.Format(currency), lowest.Format(currency), lowestDate.Format(budget.DateLayout),
+		))
+	}
+
+	index := 0
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		for ; index < len(flows) && flows[index].Date.Equal(date); index++ {
+			balance = flows[index].Balance
+		}
+		switch {
+		case balance < threshold && !low:
+			low, lowStart, lowest, lowestDate = true, date, balance, date
+		case balance < threshold && balance < lowest:
+			lowest, lowestDate = balance, date
+		case balance >= threshold && low:
+			low = false
+			warn(date.AddDate(0, 0, -1))
+		}
+	}
+	if low {
+		warn(end.AddDate(0, 0, -1))
+	}
+
+	if len(warnings) == 0 {
+		fmt.Println(text.FgHiGreen.Sprintf("The balance is projected to stay at or above %s.", threshold.Format(currency)))
+		return
+	}
+	for _, warning := range warnings {
+		fmt.Println(text.Colors{text.FgHiRed, text.Bold}.Sprint("Warning: ") + text.FgHiRed.Sprint(warning))
+	}
+}
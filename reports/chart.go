@@ -0,0 +1,430 @@
+package reports
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"golang.org/x/term"
+)
+
+const (
+	defaultChartWidth = 80 // Width of charts when the width of the terminal is unknown
+	shareChartRows    = 8  // Height of share charts
+
+	leftoverSeries = -1 // Index of the series of what is left over, such as unspent income
+	incomeSeries   = -2 // Index of the series of income
+)
+
+var (
+	// Colours of the series of charts, in order
+	chartPalette = []text.Color{
+		text.FgHiCyan, text.FgHiMagenta, text.FgHiYellow, text.FgHiBlue, text.FgHiRed, text.FgHiGreen,
+		text.FgCyan, text.FgMagenta, text.FgYellow, text.FgBlue, text.FgRed, text.FgGreen,
+	}
+
+	// Fills of the series of charts, in order, when colours cannot be drawn
+	unicodeFills = []string{"█", "▓", "▒", "░"}
+	asciiFills   = []string{"#", "=", "+", "*", "%", "@", "o", "x", "~", ":"}
+
+	// Partial blocks of bars, in eighths of a column
+	partialBlocks = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+)
+
+// chartCanvas describes the terminal that charts are drawn on
+type chartCanvas struct {
+	width   int  // Columns available
+	unicode bool // Whether Unicode block characters can be drawn
+	colors  bool // Whether colours can be drawn
+}
+
+// newChartCanvas detects the width of the terminal, and whether it can draw Unicode characters and colours
+func newChartCanvas() chartCanvas {
+	canvas := chartCanvas{
+		width:   defaultChartWidth,
+		unicode: supportsUnicode(),
+		colors:  termenv.EnvColorProfile() != termenv.Ascii,
+	}
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		canvas.width = width - 1
+	} else if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		canvas.width = columns - 1
+	}
+	return canvas
+}
+
+// supportsUnicode reports whether the locale of the terminal uses UTF-8
+func supportsUnicode() bool {
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	for _, variable := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value := strings.ToUpper(os.Getenv(variable)); value != "" {
+			return strings.Contains(value, "UTF-8") || strings.Contains(value, "UTF8")
+		}
+	}
+	return runtime.GOOS == "windows"
+}
+
+// title writes the title of a chart
+func (canvas chartCanvas) title(title string) string {
+	if canvas.colors {
+		return text.Colors{text.Bold, text.Underline}.Sprint(title)
+	}
+	return title
+}
+
+// fill writes the given number of columns of the fill of the series with the given index
+func (canvas chartCanvas) fill(index int, columns int) string {
+	if columns <= 0 {
+		return ""
+	}
+
+	var glyph string
+	switch {
+	case index == leftoverSeries && canvas.unicode:
+		glyph = "·"
+	case index == leftoverSeries:
+		glyph = "."
+	case (index == incomeSeries || canvas.colors) && canvas.unicode:
+		glyph = "█"
+	case index == incomeSeries || canvas.colors:
+		glyph = "#"
+	case canvas.unicode:
+		glyph = unicodeFills[index%len(unicodeFills)]
+	default:
+		glyph = asciiFills[index%len(asciiFills)]
+	}
+
+	fill := strings.Repeat(glyph, columns)
+	if canvas.colors {
+		switch index {
+		case leftoverSeries:
+			return text.Faint.Sprint(fill)
+		case incomeSeries:
+			return text.FgGreen.Sprint(fill)
+		default:
+			return chartPalette[index%len(chartPalette)].Sprint(fill)
+		}
+	}
+	return fill
+}
+
+// bar writes a bar of the given fractional number of columns, using partial blocks when Unicode can be drawn
+func (canvas chartCanvas) bar(index int, columns float64) string {
+	if columns <= 0 || math.IsNaN(columns) {
+		return ""
+	}
+	if !canvas.unicode || !canvas.colors {
+		return canvas.fill(index, int(math.Round(columns)))
+	}
+
+	whole := int(columns)
+	bar := strings.Repeat("█", whole) + partialBlocks[int((columns-float64(whole))*8)]
+	return chartPalette[index%len(chartPalette)].Sprint(bar)
+}
+
+// legend writes the legend of the series with the given labels, wrapped to the width of the canvas
+func (canvas chartCanvas) legend(labels []string, indices []int) string {
+	var lines []string
+	var line string
+	for position, label := range labels {
+		entry := canvas.fill(indices[position], 1) + " " + label
+		if line != "" && text.RuneCount(line)+2+text.RuneCount(entry) > canvas.width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += "  "
+		}
+		line += entry
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// allocateColumns divides a number of columns between amounts in proportion to the total, using the largest remainder method so that
+// the columns given add up exactly
+func allocateColumns(columns int, amounts []float64, total float64) []int {
+	allocated := make([]int, len(amounts))
+	if total <= 0 || columns <= 0 {
+		return allocated
+	}
+
+	remainders := make([]float64, len(amounts))
+	var given int
+	for index, amount := range amounts {
+		if amount <= 0 || math.IsNaN(amount) {
+			continue
+		}
+		exact := float64(columns) * amount / total
+		allocated[index] = int(exact)
+		remainders[index] = exact - float64(allocated[index])
+		given += allocated[index]
+	}
+
+	order := make([]int, len(amounts))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+
+	var sum float64
+	for _, amount := range amounts {
+		if amount > 0 {
+			sum += amount
+		}
+	}
+	target := int(math.Round(float64(columns) * math.Min(sum/total, 1)))
+	for _, index := range order {
+		if given >= target {
+			break
+		}
+		if remainders[index] > 0 {
+			allocated[index]++
+			given++
+		}
+	}
+	return allocated
+}
+
+// chartSeries describes a named amount drawn in a chart, and the index of its colour or fill
+type chartSeries struct {
+	name   string
+	amount quantity.Money
+	index  int
+}
+
+// makeChartSeries makes the series of the given amounts, indexed by the order of the given names, from largest to smallest amount.
+// Amounts that are not positive or could not be converted are left out.
+func makeChartSeries(names []string, amounts map[string]quantity.Money) []chartSeries {
+	series := make([]chartSeries, 0, len(names))
+	for index, name := range names {
+		if amount := amounts[name]; amount > 0 && !amount.IsInf(0) {
+			series = append(series, chartSeries{name: name, amount: amount, index: index})
+		}
+	}
+	sort.SliceStable(series, func(i, j int) bool {
+		return series[i].amount > series[j].amount
+	})
+	return series
+}
+
+// reportBarChart draws a horizontal bar chart of the given amounts by name, from largest to smallest
+func reportBarChart(canvas chartCanvas, title string, names []string, amounts map[string]quantity.Money, currency quantity.Currency) {
+	fmt.Println(canvas.title(title))
+
+	series := makeChartSeries(names, amounts)
+	if len(series) == 0 {
+		fmt.Println("Nothing to chart.")
+		return
+	}
+
+	var labelWidth, amountWidth int
+	formatted := make([]string, len(series))
+	for position, item := range series {
+		formatted[position] = item.amount.Format(currency)
+		if width := text.RuneCount(item.name); width > labelWidth {
+			labelWidth = width
+		}
+		if width := text.RuneCount(formatted[position]); width > amountWidth {
+			amountWidth = width
+		}
+	}
+	if labelWidth > canvas.width/4 {
+		labelWidth = canvas.width / 4
+	}
+	barWidth := canvas.width - labelWidth - amountWidth - 2
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	largest := series[0].amount.ValueOf()
+	for position, item := range series {
+		bar := canvas.bar(item.index, float64(barWidth)*item.amount.ValueOf()/largest)
+		fmt.Printf("%s %s %*s\n",
+			text.Pad(text.Snip(item.name, labelWidth, "~"), labelWidth, ' '),
+			text.Pad(bar, barWidth, ' '),
+			amountWidth, formatted[position],
+		)
+	}
+}
+
+// reportIncomeExpenseChart draws total income beside total expenses, with the expenses stacked by name
+func reportIncomeExpenseChart(canvas chartCanvas, title string, income quantity.Money, names []string, expenses map[string]quantity.Money, currency quantity.Currency) {
+	fmt.Println(canvas.title(title))
+
+	series := makeChartSeries(names, expenses)
+	var totalExpenses quantity.Money
+	for _, item := range series {
+		totalExpenses += item.amount
+	}
+	if income.IsNaN() || income <= 0 && totalExpenses <= 0 {
+		fmt.Println("Nothing to chart.")
+		return
+	}
+
+	labels := []string{"Income", "Expenses"}
+	totals := []string{income.Format(currency), totalExpenses.Format(currency)}
+	labelWidth := text.RuneCount(labels[1])
+	amountWidth := text.RuneCount(totals[0])
+	if width := text.RuneCount(totals[1]); width > amountWidth {
+		amountWidth = width
+	}
+	barWidth := canvas.width - labelWidth - amountWidth - 2
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	scale := math.Max(income.ValueOf(), totalExpenses.ValueOf())
+	incomeColumns := int(math.Round(float64(barWidth) * math.Max(income.ValueOf(), 0) / scale))
+	incomeBar := canvas.fill(incomeSeries, incomeColumns)
+
+	amounts := make([]float64, len(series))
+	for position, item := range series {
+		amounts[position] = item.amount.ValueOf()
+	}
+	var expenseBar strings.Builder
+	for position, columns := range allocateColumns(barWidth, amounts, scale) {
+		expenseBar.WriteString(canvas.fill(series[position].index, columns))
+	}
+
+	fmt.Printf("%s %s %*s\n", text.Pad(labels[0], labelWidth, ' '), text.Pad(incomeBar, barWidth, ' '), amountWidth, totals[0])
+	fmt.Printf("%s %s %*s\n", text.Pad(labels[1], labelWidth, ' '), text.Pad(expenseBar.String(), barWidth, ' '), amountWidth, totals[1])
+
+	legendLabels := make([]string, len(series))
+	legendIndices := make([]int, len(series))
+	for position, item := range series {
+		legendLabels[position] = fmt.Sprintf("%s %s", item.name, item.amount.Format(currency))
+		legendIndices[position] = item.index
+	}
+	fmt.Println(canvas.legend(legendLabels, legendIndices))
+}
+
+// reportShareChart draws a grid in which each expense fills a number of cells in proportion to its share of income, approximating a
+// treemap. Income left over fills the remaining cells. When expenses exceed income, the grid is in proportion to expenses instead.
+func reportShareChart(canvas chartCanvas, title string, income quantity.Money, names []string, expenses map[string]quantity.Money, currency quantity.Currency) {
+	fmt.Println(canvas.title(title))
+
+	series := makeChartSeries(names, expenses)
+	if income.IsNaN() || income <= 0 {
+		fmt.Println("Nothing to chart.")
+		return
+	}
+
+	amounts := make([]float64, 0, len(series)+1)
+	var totalExpenses float64
+	for _, item := range series {
+		amounts = append(amounts, item.amount.ValueOf())
+		totalExpenses += item.amount.ValueOf()
+	}
+	remaining := income.ValueOf() - totalExpenses
+	amounts = append(amounts, math.Max(remaining, 0))
+	total := math.Max(income.ValueOf(), totalExpenses)
+
+	// Fill the grid column by column, so each share forms a contiguous block
+	columns := canvas.width
+	cells := allocateColumns(columns*shareChartRows, amounts, total)
+	grid := make([][]int, shareChartRows)
+	for row := range grid {
+		grid[row] = make([]int, columns)
+	}
+	var cell int
+	for position, count := range cells {
+		index := leftoverSeries
+		if position < len(series) {
+			index = series[position].index
+		}
+		for ; count > 0; count-- {
+			grid[cell%shareChartRows][cell/shareChartRows] = index
+			cell++
+		}
+	}
+	for ; cell < columns*shareChartRows; cell++ {
+		grid[cell%shareChartRows][cell/shareChartRows] = leftoverSeries
+	}
+
+	for _, row := range grid {
+		var line strings.Builder
+		for start := 0; start < len(row); {
+			end := start
+			for end < len(row) && row[end] == row[start] {
+				end++
+			}
+			line.WriteString(canvas.fill(row[start], end-start))
+			start = end
+		}
+		fmt.Println(line.String())
+	}
+
+	legendLabels := make([]string, 0, len(series)+1)
+	legendIndices := make([]int, 0, len(series)+1)
+	for _, item := range series {
+		legendLabels = append(legendLabels, fmt.Sprintf("%s %s", item.name, quantity.Percentage(item.amount.ValueOf()/income.ValueOf())))
+		legendIndices = append(legendIndices, item.index)
+	}
+	if remaining > 0 {
+		legendLabels = append(legendLabels, fmt.Sprintf("Remaining %s", quantity.Percentage(remaining/income.ValueOf())))
+	} else {
+		legendLabels = append(legendLabels, fmt.Sprintf("Over by %s", quantity.Money(-remaining).Format(currency)))
+	}
+	legendIndices = append(legendIndices, leftoverSeries)
+	fmt.Println(canvas.legend(legendLabels, legendIndices))
+}
+
+// ReportCharts draws charts of the expenses of the budget by name and as shares of its income, rescaled to the given period
+func ReportCharts(chartBudget *budget.Budget, period quantity.Period) {
+	canvas := newChartCanvas()
+	currency := chartBudget.ReportingCurrency()
+
+	names := chartBudget.Expenses.SortedNames()
+	expenses := make(map[string]quantity.Money, len(names))
+	for _, name := range names {
+		expenses[name] = budget.PerPeriod(chartBudget.Expenses.Converted(name, currency), period)
+	}
+	income := budget.PerPeriod(chartBudget.Income.Sum(currency), period)
+
+	reportBarChart(canvas, periodTitle("Expenses by Name", period), names, expenses, currency)
+	fmt.Println()
+	reportIncomeExpenseChart(canvas, periodTitle("Income vs Expenses", period), income, names, expenses, currency)
+	fmt.Println()
+	reportShareChart(canvas, periodTitle("Share of Income", period), income, names, expenses, currency)
+}
+
+// ReportSpendingChart draws a chart of the money spent in the given month by category
+func ReportSpendingChart(chartBudget *budget.Budget, journal *budget.Journal, month time.Time) {
+	canvas := newChartCanvas()
+
+	names := chartBudget.Expenses.SortedNames()
+	spending := make(map[string]quantity.Money, len(names))
+	for _, transaction := range journal.Between(month, month.AddDate(0, 1, 0)) {
+		if _, isIncome := chartBudget.Income[transaction.Category]; isIncome || transaction.Amount >= 0 {
+			continue
+		}
+		category := transaction.Category
+		if category == "" {
+			category = "(Uncategorized)"
+		}
+		if _, isExpense := chartBudget.Expenses[category]; !isExpense {
+			if _, seen := spending[category]; !seen {
+				names = append(names, category)
+			}
+		}
+		spending[category] -= transaction.Amount
+	}
+
+	reportBarChart(canvas, fmt.Sprintf("Spending by Category for %s", month.Format("January 2006")), names, spending, chartBudget.ReportingCurrency())
+}
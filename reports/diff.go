@@ -0,0 +1,148 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// diffMarks are the marks and colours of items added, removed and modified between budgets
+var diffMarks = map[string]string{
+	budget.Added:    text.FgHiGreen.Sprint("+"),
+	budget.Removed:  text.FgHiRed.Sprint("-"),
+	budget.Modified: text.FgHiYellow.Sprint("~"),
+}
+
+// diffValue writes a value of a field as written in budget files, or a dash if empty
+func diffValue(value interface{}) string {
+	if value == nil {
+		return "—"
+	}
+	if valueString, ok := value.(string); ok {
+		return valueString
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// diffFields writes the fields that differ between budgets, one per line
+func diffFields(fields []budget.FieldChange) string {
+	lines := make([]string, 0, len(fields))
+	for _, field := range fields {
+		lines = append(lines, fmt.Sprintf("%s: %s → %s", field.Field, diffValue(field.Before), diffValue(field.After)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// diffDifference writes the difference of an amount between budgets with an explicit sign, or nothing if unchanged
+func diffDifference(difference quantity.Money, currency quantity.Currency) string {
+	if difference == 0 {
+		return ""
+	}
+	return signedMoney(difference, currency)
+}
+
+// ReportDiff reports the differences between two budgets: the income sources, expenses, one-time events and scenarios added,
+// removed or modified, with the fields that changed, followed by the change in monthly totals
+func ReportDiff(diff budget.Diff, beforeName string, afterName string) {
+	currency := diff.Currency
+
+	if len(diff.Fields) > 0 {
+		fmt.Println(text.Bold.Sprint("Budget"))
+		for _, line := range strings.Split(diffFields(diff.Fields), "\n") {
+			fmt.Println("  " + line)
+		}
+		fmt.Println()
+	}
+
+	if len(diff.Items) == 0 {
+		fmt.Println(text.Faint.Sprint("No income sources, expenses, events or scenarios differ."))
+	} else {
+		tableWriter := table.NewWriter()
+		tableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{
+				Number:   4,
+				WidthMax: 50,
+			},
+			{
+				Number:      5,
+				Align:       text.AlignRight,
+				AlignHeader: text.AlignRight,
+			},
+			{
+				Number:      6,
+				Align:       text.AlignRight,
+				AlignHeader: text.AlignRight,
+			},
+			{
+				Number:      7,
+				Align:       text.AlignRight,
+				AlignHeader: text.AlignRight,
+			},
+		})
+		tableWriter.SetStyle(table.StyleColoredBright)
+		tableWriter.SetTitle("Changes")
+		tableWriter.AppendHeader(table.Row{"", "Kind", "Name", "Fields", beforeName, afterName, "Difference"})
+		for _, item := range diff.Items {
+			var before, after, difference string
+			if item.Kind != budget.ScenarioItem {
+				if item.Change != budget.Added {
+					before = item.Before.Format(currency)
+				}
+				if item.Change != budget.Removed {
+					after = item.After.Format(currency)
+				}
+				difference = diffDifference(item.After-item.Before, currency)
+			}
+			tableWriter.AppendRow(table.Row{diffMarks[item.Change], item.Kind, item.Name, diffFields(item.Fields), before, after, difference})
+		}
+		fmt.Println(tableWriter.Render())
+		fmt.Println(text.Faint.Sprint("Amounts of income sources and expenses are monthly; amounts of events are one-time."))
+	}
+	fmt.Println()
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle("Monthly Totals")
+	tableWriter.AppendHeader(table.Row{"", beforeName, afterName, "Difference"})
+	for _, total := range []struct {
+		label string
+		diff  budget.TotalDiff
+	}{
+		{"Income", diff.Income},
+		{"Expenses", diff.Expenses},
+		{"Remaining", diff.Sum},
+	} {
+		tableWriter.AppendRow(table.Row{total.label, total.diff.Before.Format(currency), total.diff.After.Format(currency), diffDifference(total.diff.Difference, currency)})
+	}
+	fmt.Println(tableWriter.Render())
+
+	for _, warning := range diff.Warnings {
+		fmt.Println(text.FgYellow.Sprintf("Warning: %s; add it to the exchange rates file.", warning))
+	}
+}
@@ -6,12 +6,73 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-func reportExpenseList(list budget.ExpenseList) {
+func reportExpenseList(list budget.ExpenseList, currency quantity.Currency, period quantity.Period) {
 	tableWriter := table.NewWriter()
 
-	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+	// Only show amounts in their original currency if any differ from the currency of the budget
+	var mixed bool
+	for _, expense := range list {
+		mixed = mixed || expense.Currency.Or(currency) != currency
+	}
+
+	tableWriter.SetColumnConfigs(amountColumnConfigs(mixed))
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(periodTitle("Expenses", period))
+	if mixed {
+		tableWriter.AppendHeader(table.Row{"Index", "Name", "Original", "Amount"})
+	} else {
+		tableWriter.AppendHeader(table.Row{"Index", "Name", "Amount"})
+	}
+	index := 1
+	for _, name := range list.SortedNames() {
+		expense := list[name]
+		converted := budget.PerPeriod(list.Converted(name, currency), period).Format(currency)
+		if mixed {
+			tableWriter.AppendRow(table.Row{index, name, budget.PerPeriod(expense.MonthlyExpense(), period).Format(expense.Currency.Or(currency)), converted})
+		} else {
+			tableWriter.AppendRow(table.Row{index, name, converted})
+		}
+		index++
+	}
+	if mixed {
+		tableWriter.AppendFooter(table.Row{"Index", "Total", "", budget.PerPeriod(list.Sum(currency), period).Format(currency)})
+	} else {
+		tableWriter.AppendFooter(table.Row{"Index", "Total", budget.PerPeriod(list.Sum(currency), period).Format(currency)})
+	}
+
+	fmt.Println(tableWriter.Render())
+}
+
+// amountColumnConfigs returns the column configurations of a list of named amounts, optionally with their original amounts
+func amountColumnConfigs(withOriginal bool) []table.ColumnConfig {
+	if !withOriginal {
+		return []table.ColumnConfig{
+			{
+				Number: 1,
+				Hidden: true,
+			},
+			{
+				Number:      2,
+				Align:       text.AlignLeft,
+				AlignHeader: text.AlignLeft,
+				WidthMin:    75,
+				WidthMax:    75,
+			},
+			{
+				Number:      3,
+				Align:       text.AlignRight,
+				AlignHeader: text.AlignRight,
+				AlignFooter: text.AlignRight,
+				WidthMin:    25,
+				WidthMax:    25,
+			},
+		}
+	}
+	return []table.ColumnConfig{
 		{
 			Number: 1,
 			Hidden: true,
@@ -20,28 +81,24 @@ func reportExpenseList(list budget.ExpenseList) {
 			Number:      2,
 			Align:       text.AlignLeft,
 			AlignHeader: text.AlignLeft,
-			WidthMin:    75,
-			WidthMax:    75,
+			WidthMin:    50,
+			WidthMax:    50,
 		},
 		{
 			Number:      3,
 			Align:       text.AlignRight,
 			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+			WidthMin:    25,
+			WidthMax:    25,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
 			WidthMin:    25,
 			WidthMax:    25,
 		},
-	})
-	tableWriter.SetStyle(table.StyleColoredBright)
-
-	tableWriter.SetTitle("Expenses")
-	tableWriter.AppendHeader(table.Row{"Index", "Name", "Amount"})
-	index := 1
-	for _, name := range list.SortedNames() {
-		expense := list[name]
-		tableWriter.AppendRow(table.Row{index, name, expense})
-		index++
 	}
-	tableWriter.AppendFooter(table.Row{"Index", "Total", list.Sum()})
-
-	fmt.Println(tableWriter.Render())
 }
@@ -0,0 +1,142 @@
+package reports
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// forecastColumnConfigs returns the column configurations of a forecast, aligning amounts to the right
+func forecastColumnConfigs() []table.ColumnConfig {
+	columnConfigs := []table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+	}
+	for number := 2; number <= 7; number++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{
+			Number:      number,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		})
+	}
+	return columnConfigs
+}
+
+// forecastMoney writes an amount of a forecast, in red when negative
+func forecastMoney(money quantity.Money, currency quantity.Currency) string {
+	if money < 0 {
+		return text.FgHiRed.Sprint(money.Format(currency))
+	}
+	return money.Format(currency)
+}
+
+// forecastRow writes the row of a forecast for a month or a year
+func forecastRow(label string, period budget.ForecastMonth, currency quantity.Currency) table.Row {
+	var events string
+	if period.Events != 0 {
+		events = forecastMoney(period.Events, currency)
+	}
+	return table.Row{
+		label,
+		period.Income.Format(currency),
+		period.Expenses.Format(currency),
+		events,
+		forecastMoney(period.Net(), currency),
+		forecastMoney(period.Savings, currency),
+		forecastMoney(period.NetWorth, currency),
+	}
+}
+
+// ReportForecast reports a forecast of a budget by year, and by month if monthly, with running totals of cumulative savings and
+// net worth, followed by the one-time events within the forecast
+func ReportForecast(reportBudget *budget.Budget, forecast []budget.ForecastMonth, monthly bool) {
+	if len(forecast) == 0 {
+		return
+	}
+	currency := reportBudget.ReportingCurrency()
+	header := table.Row{"", "Income", "Expenses", "One-Time", "Net", "Cumulative Savings", "Net Worth"}
+
+	if monthly {
+		tableWriter := table.NewWriter()
+		tableWriter.SetColumnConfigs(forecastColumnConfigs())
+		tableWriter.SetStyle(table.StyleColoredBright)
+		tableWriter.SetTitle("Monthly Forecast")
+		header[0] = "Month"
+		tableWriter.AppendHeader(header)
+		for _, month := range forecast {
+			tableWriter.AppendRow(forecastRow(month.Month.Format(budget.MonthLayout), month, currency))
+		}
+		fmt.Println(tableWriter.Render())
+		fmt.Println()
+	}
+
+	// Sum the months of each year, keeping the running totals of its last month
+	var years []budget.ForecastMonth
+	var lastMonths []time.Time
+	for _, month := range forecast {
+		if len(years) == 0 || years[len(years)-1].Month.Year() != month.Month.Year() {
+			years = append(years, budget.ForecastMonth{Month: month.Month})
+			lastMonths = append(lastMonths, month.Month)
+		}
+		lastMonths[len(lastMonths)-1] = month.Month
+		year := &years[len(years)-1]
+		year.Income += month.Income
+		year.Expenses += month.Expenses
+		year.Events += month.Events
+		year.Savings = month.Savings
+		year.NetWorth = month.NetWorth
+	}
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs(forecastColumnConfigs())
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle(fmt.Sprintf(
+		"Forecast from %s to %s",
+		forecast[0].Month.Format(budget.MonthLayout),
+		forecast[len(forecast)-1].Month.Format(budget.MonthLayout),
+	))
+	header[0] = "Year"
+	tableWriter.AppendHeader(header)
+	for index, year := range years {
+		label := strconv.Itoa(year.Month.Year())
+		if year.Month.Month() != time.January || lastMonths[index].Month() != time.December {
+			label += fmt.Sprintf(" (%s-%s)", year.Month.Format("Jan"), lastMonths[index].Format("Jan"))
+		}
+		tableWriter.AppendRow(forecastRow(label, year, currency))
+	}
+	fmt.Println(tableWriter.Render())
+
+	reportForecastEvents(reportBudget, forecast[0].Month, forecast[len(forecast)-1].Month.AddDate(0, 1, 0), currency)
+
+	if last := forecast[len(forecast)-1]; last.NetWorth < 0 {
+		fmt.Println(text.FgHiRed.Sprintf("Net worth is projected to be %s by %s.", last.NetWorth.Format(currency), last.Month.Format(budget.MonthLayout)))
+	}
+	reportMissingExchangeRates(reportBudget)
+}
+
+// reportForecastEvents lists the one-time events of a budget within [start, end)
+func reportForecastEvents(reportBudget *budget.Budget, start, end time.Time, currency quantity.Currency) {
+	var lines []string
+	for _, name := range reportBudget.Events.SortedNames() {
+		event := reportBudget.Events[name]
+		if event.Date.Before(start) || !event.Date.Before(end) {
+			continue
+		}
+		amount := budget.Convert(event.Amount, event.Currency, currency, event.Date.Time)
+		lines = append(lines, fmt.Sprintf("  %s  %s  %s", event.Date, forecastMoney(amount, currency), name))
+	}
+	if len(lines) > 0 {
+		fmt.Println(text.Bold.Sprint("One-Time Events"))
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package reports
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// healthStatus writes whether a metric is within its threshold
+func healthStatus(healthy bool) string {
+	if healthy {
+		return text.Colors{text.FgHiGreen, text.Bold}.Sprint("OK")
+	}
+	return text.Colors{text.FgHiRed, text.Bold}.Sprint("WARNING")
+}
+
+// healthMetric describes a financial health metric of a budget against its threshold
+type healthMetric struct {
+	Name      string
+	Value     string
+	Threshold string
+	Healthy   bool
+}
+
+// healthMetrics calculates the financial health metrics of a budget against budget.HealthThresholds. Metrics that depend on expenses
+// being tagged as debt, housing or fixed, or on a recorded savings balance, are only calculated when that information is available;
+// otherwise, the tags that are missing are returned.
+func healthMetrics(reportBudget *budget.Budget) ([]healthMetric, []string) {
+	health := reportBudget.Health()
+	thresholds := budget.HealthThresholds
+	currency := reportBudget.ReportingCurrency()
+
+	var metrics []healthMetric
+	var missing []string
+
+	savingsRate := health.SavingsRate()
+	metrics = append(metrics, healthMetric{"Savings Rate", savingsRate.String(), fmt.Sprintf("at least %s", thresholds.SavingsRate), savingsRate >= thresholds.SavingsRate})
+
+	expenseRatio := health.ExpenseRatio()
+	metrics = append(metrics, healthMetric{"Expense-to-Income Ratio", expenseRatio.String(), fmt.Sprintf("at most %s", thresholds.ExpenseRatio), expenseRatio <= thresholds.ExpenseRatio})
+
+	if health.Debt > 0 {
+		debtRatio := health.DebtRatio()
+		metrics = append(metrics, healthMetric{"Debt-to-Income Ratio", debtRatio.String(), fmt.Sprintf("at most %s", thresholds.DebtRatio), debtRatio <= thresholds.DebtRatio})
+	} else {
+		missing = append(missing, "debt payments with --kind debt")
+	}
+
+	if health.Housing > 0 {
+		housingRatio := health.HousingRatio()
+		metrics = append(metrics, healthMetric{"Housing Cost Ratio", housingRatio.String(), fmt.Sprintf("at most %s", thresholds.HousingRatio), housingRatio <= thresholds.HousingRatio})
+	} else {
+		missing = append(missing, "housing costs with --kind housing")
+	}
+
+	if health.Balance > 0 {
+		months := health.EmergencyFundMonths()
+		metrics = append(metrics, healthMetric{
+			fmt.Sprintf("Emergency Fund (%s saved)", health.Balance.Format(currency)),
+			fmt.Sprintf("%s months", quantity.Number(math.Round(months.ValueOf()*10)/10)),
+			fmt.Sprintf("at least %s months", thresholds.EmergencyFundMonths),
+			months >= thresholds.EmergencyFundMonths,
+		})
+	}
+
+	if health.Fixed > 0 {
+		fixedRatio := health.FixedExpenseRatio()
+		metrics = append(metrics, healthMetric{
+			fmt.Sprintf("Fixed Expenses (%s fixed, %s discretionary)", health.Fixed.Format(currency), health.Discretionary.Format(currency)),
+			fixedRatio.String(),
+			fmt.Sprintf("at most %s", thresholds.FixedExpenseRatio),
+			fixedRatio <= thresholds.FixedExpenseRatio,
+		})
+	} else {
+		missing = append(missing, "fixed costs with --fixed")
+	}
+
+	return metrics, missing
+}
+
+// reportHealth reports metrics of the financial health of a budget against budget.HealthThresholds
+func reportHealth(reportBudget *budget.Budget) {
+	metrics, missing := healthMetrics(reportBudget)
+
+	tableWriter := table.NewWriter()
+
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle("Financial Health")
+	tableWriter.AppendHeader(table.Row{"Metric", "Value", "Threshold", "Status"})
+	for _, metric := range metrics {
+		tableWriter.AppendRow(table.Row{metric.Name, metric.Value, metric.Threshold, healthStatus(metric.Healthy)})
+	}
+
+	fmt.Println(tableWriter.Render())
+
+	if len(missing) > 0 {
+		fmt.Println(text.Faint.Sprintf("Tag %s using \"budgetbuddy tag\" to see more metrics.", strings.Join(missing, ", ")))
+	}
+	if reportBudget.Savings <= 0 {
+		fmt.Println(text.Faint.Sprint("Record a savings balance using \"budgetbuddy savings\" to see how many months of spending it covers."))
+	}
+}
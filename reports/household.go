@@ -0,0 +1,83 @@
+package reports
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// splitDescriptions describe the methods of splitting shared expenses
+var splitDescriptions = map[string]string{
+	budget.IncomeSplit: "in proportion to the income of each member",
+	budget.EqualSplit:  "equally between members",
+	budget.RatioSplit:  "by a fixed share for each member",
+}
+
+// ReportHousehold reports the budgets of the members of a household combined, rescaled to the given period, followed by what each
+// member contributes to the shared expenses and has remaining
+func ReportHousehold(household *budget.Household, members map[string]*budget.Budget, period quantity.Period) {
+	combined := household.Combine(members)
+	ReportBudget(combined, period)
+	fmt.Println()
+
+	currency := household.ReportingCurrency()
+	tableWriter := table.NewWriter()
+	columnConfigs := []table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+	}
+	for number := 2; number <= 6; number++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{
+			Number:      number,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+		})
+	}
+	tableWriter.SetColumnConfigs(columnConfigs)
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle(periodTitle("Contributions", period))
+	tableWriter.AppendHeader(table.Row{"Member", "Income", "Own Expenses", "Share", "Contribution", "Remaining"})
+
+	shares := household.Shares(members)
+	var total budget.MemberShare
+	for _, share := range shares {
+		tableWriter.AppendRow(table.Row{
+			share.Name,
+			budget.PerPeriod(share.Income, period).Format(currency),
+			budget.PerPeriod(share.Expenses, period).Format(currency),
+			share.Share,
+			budget.PerPeriod(share.Contribution, period).Format(currency),
+			forecastMoney(budget.PerPeriod(share.Remaining(), period), currency),
+		})
+		total.Income += share.Income
+		total.Expenses += share.Expenses
+		total.Contribution += share.Contribution
+	}
+	tableWriter.AppendFooter(table.Row{
+		"Total",
+		budget.PerPeriod(total.Income, period).Format(currency),
+		budget.PerPeriod(total.Expenses, period).Format(currency),
+		"",
+		budget.PerPeriod(total.Contribution, period).Format(currency),
+		budget.PerPeriod(total.Remaining(), period).Format(currency),
+	})
+	fmt.Println(tableWriter.Render())
+
+	method := household.Split.Method
+	if method == "" {
+		method = budget.IncomeSplit
+	}
+	fmt.Println(text.Faint.Sprintf("Shared expenses are split %s.", splitDescriptions[method]))
+	for _, share := range shares {
+		if share.Remaining() < 0 {
+			fmt.Println(text.FgHiRed.Sprintf("%s is short %s after their contribution.", share.Name, budget.PerPeriod(-share.Remaining(), period).Format(currency)))
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package reports
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Colours of the series of HTML charts, in order
+var htmlPalette = []string{
+	"#0891b2", "#c026d3", "#ca8a04", "#2563eb", "#dc2626", "#16a34a",
+	"#67e8f9", "#f0abfc", "#fde047", "#93c5fd", "#fca5a5", "#86efac",
+}
+
+// Colour of what is left over in HTML charts, such as unspent income
+const htmlLeftoverColor = "#d4d4d8"
+
+// htmlRow describes a row of a table of an HTML report
+type htmlRow struct {
+	Name     string
+	Original string // Amount in its original currency, if it differs from the currency of the budget
+	Amount   string
+	Share    string // Share of income
+}
+
+// htmlTable describes a table of named amounts of an HTML report
+type htmlTable struct {
+	Title  string
+	Mixed  bool // Whether any amount is in another currency than the budget
+	Shares bool // Whether amounts are shown as shares of income
+	Rows   []htmlRow
+	Total  string
+}
+
+// htmlReport describes the contents of an HTML report
+type htmlReport struct {
+	Name      string
+	Currency  quantity.Currency
+	Period    string
+	Generated string
+	Income    htmlTable
+	Expenses  htmlTable
+	Summary   struct {
+		Income    string
+		Expenses  string
+		Remaining string
+		Deficit   bool
+	}
+	Categories []htmlTable
+	Health     []healthMetric
+	Missing    []string
+	Charts     []template.HTML
+	Warnings   []string
+}
+
+// htmlSlice describes a named amount drawn in an HTML chart
+type htmlSlice struct {
+	name   string
+	amount float64
+	label  string // Amount as written beside its bar or in the legend
+	color  string
+}
+
+// htmlAmount returns an amount for charts, treating amounts that could not be converted as nothing
+func htmlAmount(money quantity.Money) float64 {
+	if money.IsNaN() || money < 0 {
+		return 0
+	}
+	return money.ValueOf()
+}
+
+// htmlBarChart draws a horizontal bar chart of named amounts as SVG, scaled to the largest amount
+func htmlBarChart(title string, slices []htmlSlice) template.HTML {
+	const (
+		width      = 640
+		labelWidth = 180
+		valueWidth = 110
+		rowHeight  = 28
+		barHeight  = 18
+	)
+
+	var largest float64
+	for _, slice := range slices {
+		largest = math.Max(largest, slice.amount)
+	}
+
+	var svg strings.Builder
+	height := rowHeight*len(slices) + 8
+	fmt.Fprintf(&svg, `<figure><figcaption>%s</figcaption>`, html.EscapeString(title))
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="100%%" role="img" aria-label="%s">`, width, height, html.EscapeString(title))
+	for index, slice := range slices {
+		y := rowHeight*index + 4
+		barWidth := 0.0
+		if largest > 0 {
+			barWidth = slice.amount / largest * (width - labelWidth - valueWidth)
+		}
+		fmt.Fprintf(&svg, `<text x="%d" y="%d" text-anchor="end" class="label">%s</text>`, labelWidth-8, y+barHeight-4, html.EscapeString(slice.name))
+		fmt.Fprintf(&svg, `<rect x="%d" y="%d" width="%.2f" height="%d" rx="3" fill="%s"><title>%s: %s</title></rect>`,
+			labelWidth, y, barWidth, barHeight, slice.color, html.EscapeString(slice.name), html.EscapeString(slice.label))
+		fmt.Fprintf(&svg, `<text x="%.2f" y="%d" class="value">%s</text>`, float64(labelWidth)+barWidth+6, y+barHeight-4, html.EscapeString(slice.label))
+	}
+	svg.WriteString(`</svg></figure>`)
+	return template.HTML(svg.String())
+}
+
+// htmlDonutChart draws a donut chart of the shares of a whole as SVG, with a legend. Each slice is a circle whose stroke is dashed
+// to the length of its share, since the circumference of a circle with a radius of 100/2π is 100.
+func htmlDonutChart(title string, slices []htmlSlice) template.HTML {
+	var whole float64
+	for _, slice := range slices {
+		whole += slice.amount
+	}
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<figure><figcaption>%s</figcaption><div class="donut">`, html.EscapeString(title))
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 42 42" width="220" height="220" role="img" aria-label="%s">`, html.EscapeString(title))
+	svg.WriteString(`<circle cx="21" cy="21" r="15.9155" fill="none" stroke="#f4f4f5" stroke-width="6"/>`)
+	offset := 25.0 // Start at the top of the circle
+	for _, slice := range slices {
+		if whole <= 0 || slice.amount <= 0 {
+			continue
+		}
+		share := slice.amount / whole * 100
+		fmt.Fprintf(&svg, `<circle cx="21" cy="21" r="15.9155" fill="none" stroke="%s" stroke-width="6" stroke-dasharray="%.3f %.3f" stroke-dashoffset="%.3f"><title>%s: %s</title></circle>`,
+			slice.color, share, 100-share, offset, html.EscapeString(slice.name), html.EscapeString(slice.label))
+		offset -= share
+	}
+	svg.WriteString(`</svg><ul class="legend">`)
+	for _, slice := range slices {
+		fmt.Fprintf(&svg, `<li><span class="swatch" style="background:%s"></span>%s <span class="muted">%s</span></li>`,
+			slice.color, html.EscapeString(slice.name), html.EscapeString(slice.label))
+	}
+	svg.WriteString(`</ul></div></figure>`)
+	return template.HTML(svg.String())
+}
+
+// htmlAmountTable makes a table of the named amounts of income sources or expenses rescaled to the given period
+func htmlAmountTable(title string, names []string, converted func(name string) quantity.Money, original func(name string) (quantity.Money, quantity.Currency), total quantity.Money, income quantity.Money, currency quantity.Currency, period quantity.Period) htmlTable {
+	table := htmlTable{Title: periodTitle(title, period), Shares: income > 0, Total: budget.PerPeriod(total, period).Format(currency)}
+	for _, name := range names {
+		_, originalCurrency := original(name)
+		table.Mixed = table.Mixed || originalCurrency.Or(currency) != currency
+	}
+	for _, name := range names {
+		amount := converted(name)
+		row := htmlRow{Name: name, Amount: budget.PerPeriod(amount, period).Format(currency)}
+		if income > 0 {
+			row.Share = quantity.Percentage(amount.ValueOf() / income.ValueOf()).String()
+		}
+		if table.Mixed {
+			originalAmount, originalCurrency := original(name)
+			row.Original = budget.PerPeriod(originalAmount, period).Format(originalCurrency.Or(currency))
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// htmlCategoryTable makes a table of the expenses summed by a category of expense, such as their bucket, from largest to smallest
+func htmlCategoryTable(title string, amounts map[string]quantity.Money, income quantity.Money, currency quantity.Currency, period quantity.Period) htmlTable {
+	categories := make([]string, 0, len(amounts))
+	var total quantity.Money
+	for category, amount := range amounts {
+		categories = append(categories, category)
+		total += amount
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if amounts[categories[i]] == amounts[categories[j]] {
+			return categories[i] < categories[j]
+		}
+		return amounts[categories[i]] > amounts[categories[j]]
+	})
+
+	table := htmlTable{Title: periodTitle(title, period), Shares: income > 0, Total: budget.PerPeriod(total, period).Format(currency)}
+	for _, category := range categories {
+		row := htmlRow{Name: category, Amount: budget.PerPeriod(amounts[category], period).Format(currency)}
+		if income > 0 {
+			row.Share = quantity.Percentage(amounts[category].ValueOf() / income.ValueOf()).String()
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// WriteHTML writes a report of a budget as a single self-contained HTML page, with its styles and charts inline, rescaled to the given
+// period. The page has the same tables as ReportBudget, breakdowns of the expenses by bucket and by kind, and charts of the expenses.
+func WriteHTML(writer io.Writer, name string, htmlBudget *budget.Budget, period quantity.Period) error {
+	currency := htmlBudget.ReportingCurrency()
+	income := htmlBudget.Income.Sum(currency)
+	expenses := htmlBudget.Expenses.Sum(currency)
+	remaining := htmlBudget.Sum()
+
+	report := htmlReport{
+		Name:      name,
+		Currency:  currency,
+		Generated: time.Now().Format("January 2, 2006"),
+	}
+	if period != quantity.Month {
+		report.Period = period.String()
+	}
+
+	report.Income = htmlAmountTable("Income", htmlBudget.Income.SortedNames(),
+		func(name string) quantity.Money { return htmlBudget.Income.Converted(name, currency) },
+		func(name string) (quantity.Money, quantity.Currency) {
+			return htmlBudget.Income[name].MonthlyIncome(), htmlBudget.Income[name].Attributes().Currency
+		},
+		income, 0, currency, period)
+	report.Expenses = htmlAmountTable("Expenses", htmlBudget.Expenses.SortedNames(),
+		func(name string) quantity.Money { return htmlBudget.Expenses.Converted(name, currency) },
+		func(name string) (quantity.Money, quantity.Currency) {
+			return htmlBudget.Expenses[name].MonthlyExpense(), htmlBudget.Expenses[name].Currency
+		},
+		expenses, income, currency, period)
+
+	report.Summary.Income = budget.PerPeriod(income, period).Format(currency)
+	report.Summary.Expenses = budget.PerPeriod(expenses, period).Format(currency)
+	report.Summary.Remaining = budget.PerPeriod(remaining, period).Format(currency)
+	report.Summary.Deficit = remaining < 0
+
+	// Break the expenses down by bucket and by kind
+	buckets := make(map[string]quantity.Money)
+	for bucket, amount := range htmlBudget.Expenses.Buckets(currency) {
+		if bucket == "" {
+			bucket = "(Untagged)"
+		}
+		buckets[strings.Title(bucket)] += amount
+	}
+	kinds := make(map[string]quantity.Money)
+	for expenseName, expense := range htmlBudget.Expenses {
+		kind := "Other"
+		switch expense.Kind {
+		case budget.HousingKind:
+			kind = "Housing"
+		case budget.DebtKind:
+			kind = "Debt"
+		}
+		if expense.Fixed {
+			kind += ", fixed"
+		} else {
+			kind += ", discretionary"
+		}
+		kinds[kind] += htmlBudget.Expenses.Converted(expenseName, currency)
+	}
+	report.Categories = []htmlTable{
+		htmlCategoryTable("Expenses by Bucket", buckets, income, currency, period),
+		htmlCategoryTable("Expenses by Kind", kinds, income, currency, period),
+	}
+
+	report.Health, report.Missing = healthMetrics(htmlBudget)
+
+	// Chart the expenses by name, by bucket, and as shares of income
+	var byName, byBucket, shares []htmlSlice
+	for index, expenseName := range htmlBudget.Expenses.SortedNames() {
+		amount := budget.PerPeriod(htmlBudget.Expenses.Converted(expenseName, currency), period)
+		slice := htmlSlice{expenseName, htmlAmount(amount), amount.Format(currency), htmlPalette[index%len(htmlPalette)]}
+		byName = append(byName, slice)
+		shares = append(shares, slice)
+	}
+	for index, row := range report.Categories[0].Rows {
+		byBucket = append(byBucket, htmlSlice{row.Name, htmlAmount(budget.PerPeriod(buckets[row.Name], period)), row.Amount, htmlPalette[index%len(htmlPalette)]})
+	}
+	if remaining > 0 {
+		shares = append(shares, htmlSlice{"Remaining", htmlAmount(budget.PerPeriod(remaining, period)), report.Summary.Remaining, htmlLeftoverColor})
+	}
+	whole := math.Max(htmlAmount(budget.PerPeriod(income, period)), htmlAmount(budget.PerPeriod(expenses, period)))
+	for index := range shares {
+		if whole > 0 {
+			shares[index].label = fmt.Sprintf("%s (%s)", shares[index].label, quantity.Percentage(shares[index].amount/whole))
+		}
+	}
+	if len(byName) > 0 {
+		report.Charts = append(report.Charts,
+			htmlBarChart(periodTitle("Expenses by Name", period), byName),
+			htmlBarChart(periodTitle("Expenses by Bucket", period), byBucket),
+		)
+		if remaining >= 0 {
+			report.Charts = append(report.Charts, htmlDonutChart(periodTitle("Share of Income", period), shares))
+		} else {
+			report.Charts = append(report.Charts, htmlDonutChart(fmt.Sprintf("%s, Over by %s", periodTitle("Share of Expenses", period), quantity.Money(-budget.PerPeriod(remaining, period)).Format(currency)), shares))
+		}
+	}
+	report.Charts = append(report.Charts, htmlBarChart(periodTitle("Income vs Expenses", period), []htmlSlice{
+		{"Income", htmlAmount(budget.PerPeriod(income, period)), report.Summary.Income, htmlPalette[5]},
+		{"Expenses", htmlAmount(budget.PerPeriod(expenses, period)), report.Summary.Expenses, htmlPalette[4]},
+	}))
+
+	for _, err := range htmlBudget.MissingExchangeRates() {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("%s; add it to the exchange rates file.", err))
+	}
+
+	return htmlReportTemplate.Execute(writer, report)
+}
+
+// htmlReportTemplate is the template of HTML reports. Everything is inline, so the page can be shared as a single file.
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Budget {{.Name}}</title>
+<style>
+	body { font-family: system-ui, -apple-system, "Segoe UI", Roboto, sans-serif; color: #18181b; background: #fafafa; margin: 0; }
+	main { max-width: 960px; margin: 0 auto; padding: 2rem 1rem; }
+	h1 { margin: 0 0 .25rem; }
+	h2 { margin: 2rem 0 .75rem; font-size: 1.25rem; }
+	.muted { color: #71717a; }
+	.grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(280px, 1fr)); gap: 1.5rem; }
+	.cards { display: grid; grid-template-columns: repeat(3, 1fr); gap: 1rem; margin-top: 1.5rem; }
+	.card { background: #fff; border: 1px solid #e4e4e7; border-radius: 8px; padding: 1rem; }
+	.card .amount { font-size: 1.5rem; font-weight: 600; margin-top: .25rem; }
+	.positive { color: #15803d; }
+	.negative { color: #b91c1c; }
+	table { width: 100%; border-collapse: collapse; background: #fff; border: 1px solid #e4e4e7; border-radius: 8px; overflow: hidden; }
+	caption { text-align: left; font-weight: 600; padding: .5rem 0; }
+	th, td { padding: .5rem .75rem; border-bottom: 1px solid #f4f4f5; text-align: left; }
+	th { background: #f4f4f5; font-weight: 600; }
+	td.number, th.number { text-align: right; font-variant-numeric: tabular-nums; }
+	tfoot td { font-weight: 600; border-top: 2px solid #e4e4e7; }
+	.status { font-weight: 600; }
+	.ok { color: #15803d; }
+	.warning { color: #b91c1c; }
+	.notice { background: #fef9c3; border: 1px solid #fde047; border-radius: 8px; padding: .75rem 1rem; margin-top: 1rem; }
+	figure { margin: 0; background: #fff; border: 1px solid #e4e4e7; border-radius: 8px; padding: 1rem; }
+	figcaption { font-weight: 600; margin-bottom: .75rem; }
+	svg .label { font-size: 12px; fill: #3f3f46; }
+	svg .value { font-size: 12px; fill: #71717a; }
+	.donut { display: flex; flex-wrap: wrap; align-items: center; gap: 1rem; }
+	.legend { list-style: none; margin: 0; padding: 0; font-size: .875rem; }
+	.legend li { margin: .25rem 0; }
+	.swatch { display: inline-block; width: .75rem; height: .75rem; border-radius: 2px; margin-right: .5rem; vertical-align: middle; }
+	footer { margin-top: 2rem; font-size: .875rem; }
+	@media print { body { background: #fff; } figure, table, .card { break-inside: avoid; } }
+</style>
+</head>
+<body>
+<main>
+<header>
+	<h1>Budget {{.Name}}</h1>
+	<div class="muted">{{if .Period}}Amounts per {{.Period}}{{else}}Monthly amounts{{end}} in {{.Currency}}, as of {{.Generated}}</div>
+</header>
+{{range .Warnings}}<div class="notice">{{.}}</div>
+{{end}}
+<section class="cards">
+	<div class="card"><div class="muted">Income</div><div class="amount">{{.Summary.Income}}</div></div>
+	<div class="card"><div class="muted">Expenses</div><div class="amount">{{.Summary.Expenses}}</div></div>
+	<div class="card"><div class="muted">Remaining</div><div class="amount {{if .Summary.Deficit}}negative{{else}}positive{{end}}">{{.Summary.Remaining}}</div></div>
+</section>
+{{define "amounts"}}<table>
+	<caption>{{.Title}}</caption>
+	<thead><tr><th>Name</th>{{if .Mixed}}<th class="number">Original</th>{{end}}<th class="number">Amount</th>{{if .Shares}}<th class="number">Share of Income</th>{{end}}</tr></thead>
+	<tbody>
+	{{- range .Rows}}
+		<tr><td>{{.Name}}</td>{{if $.Mixed}}<td class="number">{{.Original}}</td>{{end}}<td class="number">{{.Amount}}</td>{{if $.Shares}}<td class="number">{{.Share}}</td>{{end}}</tr>
+	{{- else}}
+		<tr><td colspan="4" class="muted">None</td></tr>
+	{{- end}}
+	</tbody>
+	<tfoot><tr><td>Total</td>{{if .Mixed}}<td></td>{{end}}<td class="number">{{.Total}}</td>{{if .Shares}}<td></td>{{end}}</tr></tfoot>
+</table>{{end}}
+<h2>Income and Expenses</h2>
+<section class="grid">
+	{{template "amounts" .Income}}
+	{{template "amounts" .Expenses}}
+</section>
+<h2>Summary</h2>
+<table>
+	<thead><tr><th class="number">Income</th><th class="number">Expenses</th><th class="number">Remaining</th></tr></thead>
+	<tbody><tr><td class="number">{{.Summary.Income}}</td><td class="number">{{.Summary.Expenses}}</td><td class="number {{if .Summary.Deficit}}negative{{else}}positive{{end}}">{{.Summary.Remaining}}</td></tr></tbody>
+</table>
+<h2>Categories</h2>
+<section class="grid">
+	{{range .Categories}}{{template "amounts" .}}
+	{{end}}
+</section>
+<h2>Charts</h2>
+<section class="grid">
+	{{range .Charts}}{{.}}
+	{{end}}
+</section>
+<h2>Financial Health</h2>
+<table>
+	<thead><tr><th>Metric</th><th class="number">Value</th><th class="number">Threshold</th><th>Status</th></tr></thead>
+	<tbody>
+	{{- range .Health}}
+		<tr><td>{{.Name}}</td><td class="number">{{.Value}}</td><td class="number">{{.Threshold}}</td><td class="status {{if .Healthy}}ok{{else}}warning{{end}}">{{if .Healthy}}OK{{else}}Warning{{end}}</td></tr>
+	{{- end}}
+	</tbody>
+</table>
+{{if .Missing}}<p class="muted">Tag {{range $index, $missing := .Missing}}{{if $index}}, {{end}}{{$missing}}{{end}} using "budgetbuddy tag" to see more metrics.</p>{{end}}
+<footer class="muted">Generated by budgetbuddy. Health metrics are monthly.</footer>
+</main>
+</body>
+</html>
+`))
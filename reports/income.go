@@ -4,44 +4,44 @@ import (
 	"fmt"
 
 	"github.com/jedib0t/go-pretty/v6/table"
-	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-func reportIncomeList(list budget.IncomeList) {
+func reportIncomeList(list budget.IncomeList, currency quantity.Currency, period quantity.Period) {
 	tableWriter := table.NewWriter()
 
-	tableWriter.SetColumnConfigs([]table.ColumnConfig{
-		{
-			Number: 1,
-			Hidden: true,
-		},
-		{
-			Number:      2,
-			Align:       text.AlignLeft,
-			AlignHeader: text.AlignLeft,
-			WidthMin:    75,
-			WidthMax:    75,
-		},
-		{
-			Number:      3,
-			Align:       text.AlignRight,
-			AlignHeader: text.AlignRight,
-			WidthMin:    25,
-			WidthMax:    25,
-		},
-	})
+	// Only show amounts in their original currency if any differ from the currency of the budget
+	var mixed bool
+	for _, income := range list {
+		mixed = mixed || income.Attributes().Currency.Or(currency) != currency
+	}
+
+	tableWriter.SetColumnConfigs(amountColumnConfigs(mixed))
 	tableWriter.SetStyle(table.StyleColoredBright)
 
-	tableWriter.SetTitle("Income")
-	tableWriter.AppendHeader(table.Row{"Index", "Name", "Amount"})
+	tableWriter.SetTitle(periodTitle("Income", period))
+	if mixed {
+		tableWriter.AppendHeader(table.Row{"Index", "Name", "Original", "Amount"})
+	} else {
+		tableWriter.AppendHeader(table.Row{"Index", "Name", "Amount"})
+	}
 	index := 1
 	for _, name := range list.SortedNames() {
 		income := list[name]
-		tableWriter.AppendRow(table.Row{index, name, income.MonthlyIncome()})
+		converted := budget.PerPeriod(list.Converted(name, currency), period).Format(currency)
+		if mixed {
+			tableWriter.AppendRow(table.Row{index, name, budget.PerPeriod(income.MonthlyIncome(), period).Format(income.Attributes().Currency.Or(currency)), converted})
+		} else {
+			tableWriter.AppendRow(table.Row{index, name, converted})
+		}
 		index++
 	}
-	tableWriter.AppendFooter(table.Row{"Index", "Total", list.Sum()})
+	if mixed {
+		tableWriter.AppendFooter(table.Row{"Index", "Total", "", budget.PerPeriod(list.Sum(currency), period).Format(currency)})
+	} else {
+		tableWriter.AppendFooter(table.Row{"Index", "Total", budget.PerPeriod(list.Sum(currency), period).Format(currency)})
+	}
 
 	fmt.Println(tableWriter.Render())
 }
@@ -0,0 +1,78 @@
+package reports
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// ReportPaycheck divides each expense of a budget across the paychecks of the named income source, paid every given period,
+// to show what each paycheck must cover. Amounts are averaged over the year, so months with an extra paycheck are noted.
+func ReportPaycheck(reportBudget *budget.Budget, name string, paidEvery quantity.Period) {
+	currency := reportBudget.ReportingCurrency()
+	paycheck := budget.PerPeriod(reportBudget.Income.Converted(name, currency), paidEvery)
+	share := func(money quantity.Money) quantity.Percentage {
+		return quantity.Percentage(money.ValueOf() / paycheck.ValueOf())
+	}
+
+	tableWriter := table.NewWriter()
+
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    40,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			AlignFooter: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(fmt.Sprintf("Per Paycheck from %s (every %s)", name, paidEvery))
+	tableWriter.AppendHeader(table.Row{"Expense", "Monthly", "Per Paycheck", "Of Paycheck"})
+
+	var total quantity.Money
+	for _, expenseName := range reportBudget.Expenses.SortedNames() {
+		monthly := reportBudget.Expenses.Converted(expenseName, currency)
+		perPaycheck := budget.PerPeriod(monthly, paidEvery)
+		tableWriter.AppendRow(table.Row{expenseName, monthly.Format(currency), perPaycheck.Format(currency), share(perPaycheck)})
+		total += perPaycheck
+	}
+	tableWriter.AppendFooter(table.Row{"Total", reportBudget.Expenses.Sum(currency).Format(currency), total.Format(currency), share(total)})
+
+	fmt.Println(tableWriter.Render())
+
+	if leftOver := paycheck - total; leftOver >= 0 {
+		fmt.Println(text.FgHiGreen.Sprintf("Each paycheck of %s covers its share of expenses, leaving %s.", paycheck.Format(currency), leftOver.Format(currency)))
+	} else {
+		fmt.Println(text.FgHiRed.Sprintf("Each paycheck of %s falls short of its share of expenses by %s; other income must cover the rest.", paycheck.Format(currency), (-leftOver).Format(currency)))
+	}
+
+	// Paychecks that do not fall evenly into months, such as every 2 weeks, give some months an extra paycheck
+	perYear := paidEvery.In(quantity.Year)
+	if perYear.IsInt() && perYear.Num().Int64() > 12 {
+		if extra := perYear.Num().Int64() % 12; extra != 0 {
+			fmt.Println(text.Faint.Sprintf("%s is paid %d times a year, so %d months have an extra paycheck.", name, perYear.Num().Int64(), extra))
+		}
+	}
+}
@@ -3,13 +3,35 @@ package reports
 import (
 	"fmt"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-func ReportBudget(budget *budget.Budget) {
-	reportIncomeList(budget.Income)
+// ReportBudget reports the income and expenses of a budget rescaled to the given period, such as a week or a year.
+// Financial health metrics are always reported monthly.
+func ReportBudget(budget *budget.Budget, period quantity.Period) {
+	reportIncomeList(budget.Income, budget.ReportingCurrency(), period)
 	fmt.Println()
-	reportExpenseList(budget.Expenses)
+	reportExpenseList(budget.Expenses, budget.ReportingCurrency(), period)
 	fmt.Println()
-	reportSummary(budget)
+	reportSummary(budget, period)
+	fmt.Println()
+	reportHealth(budget)
+	reportMissingExchangeRates(budget)
+}
+
+// reportMissingExchangeRates warns about amounts that could not be converted into the currency of the budget
+func reportMissingExchangeRates(budget *budget.Budget) {
+	for _, err := range budget.MissingExchangeRates() {
+		fmt.Println(text.FgYellow.Sprintf("Warning: %s; add it to the exchange rates file.", err))
+	}
+}
+
+// periodTitle qualifies the title of a report with the period its amounts are rescaled to, unless they are monthly
+func periodTitle(title string, period quantity.Period) string {
+	if period == quantity.Month {
+		return title
+	}
+	return fmt.Sprintf("%s per %s", title, period)
 }
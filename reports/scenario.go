@@ -0,0 +1,186 @@
+package reports
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// scenarioTotals describes the totals of a budget compared between scenarios, rescaled to a period
+type scenarioTotals struct {
+	income      quantity.Money
+	expenses    quantity.Money
+	remaining   quantity.Money
+	savingsRate quantity.Percentage
+}
+
+// totalScenario totals a budget, rescaled to the given period
+func totalScenario(totalBudget *budget.Budget, period quantity.Period) scenarioTotals {
+	currency := totalBudget.ReportingCurrency()
+	return scenarioTotals{
+		income:      budget.PerPeriod(totalBudget.Income.Sum(currency), period),
+		expenses:    budget.PerPeriod(totalBudget.Expenses.Sum(currency), period),
+		remaining:   budget.PerPeriod(totalBudget.Sum(), period),
+		savingsRate: totalBudget.Health().SavingsRate(),
+	}
+}
+
+// scenarioDifference writes the difference of an amount between scenarios, in green when it is better and red when it is worse
+func scenarioDifference(difference string, better bool, worse bool) string {
+	switch {
+	case better:
+		return text.FgHiGreen.Sprint(difference)
+	case worse:
+		return text.FgHiRed.Sprint(difference)
+	default:
+		return difference
+	}
+}
+
+// signedMoney writes an amount with an explicit sign
+func signedMoney(money quantity.Money, currency quantity.Currency) string {
+	if money > 0 {
+		return "+" + money.Format(currency)
+	}
+	return money.Format(currency)
+}
+
+// ReportScenarios lists the scenarios of a budget, with what each changes
+func ReportScenarios(reportBudget *budget.Budget) {
+	if len(reportBudget.Scenarios) == 0 {
+		fmt.Println(text.Faint.Sprint("No scenarios; create one with \"budgetbuddy scenario create\"."))
+		return
+	}
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:   2,
+			WidthMax: 40,
+		},
+		{
+			Number:   3,
+			WidthMax: 60,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle("Scenarios")
+	tableWriter.AppendHeader(table.Row{"Name", "Description", "Changes"})
+	for _, name := range reportBudget.Scenarios.SortedNames() {
+		changes := scenarioChanges(reportBudget, reportBudget.Scenarios[name])
+		tableWriter.AppendRow(table.Row{name, reportBudget.Scenarios[name].Description, strings.Join(changes, "\n")})
+	}
+	fmt.Println(tableWriter.Render())
+}
+
+// scenarioChanges describes each override of a scenario on top of a budget
+func scenarioChanges(reportBudget *budget.Budget, scenario *budget.Scenario) []string {
+	var changes []string
+	for _, name := range scenario.Remove {
+		changes = append(changes, fmt.Sprintf("Removes %s", name))
+	}
+	for _, name := range scenario.Income.SortedNames() {
+		if _, replaced := reportBudget.Income[name]; replaced {
+			changes = append(changes, fmt.Sprintf("Changes income %s", name))
+		} else {
+			changes = append(changes, fmt.Sprintf("Adds income %s", name))
+		}
+	}
+	for _, name := range scenario.Expenses.SortedNames() {
+		if _, replaced := reportBudget.Expenses[name]; replaced {
+			changes = append(changes, fmt.Sprintf("Changes expense %s", name))
+		} else {
+			changes = append(changes, fmt.Sprintf("Adds expense %s", name))
+		}
+	}
+	if scenario.NetPayPercentage != nil {
+		changes = append(changes, fmt.Sprintf("Assumes net pay of %s", *scenario.NetPayPercentage))
+	}
+	if scenario.Savings != nil {
+		changes = append(changes, fmt.Sprintf("Assumes savings of %s", scenario.Savings.Format(reportBudget.ReportingCurrency())))
+	}
+	return changes
+}
+
+// ReportScenario reports the budget of the named scenario rescaled to the given period, followed by a comparison of its totals
+// to those of the base budget.
+func ReportScenario(reportBudget *budget.Budget, name string, period quantity.Period) error {
+	scenarioBudget, err := reportBudget.Scenario(name)
+	if err != nil {
+		return err
+	}
+	scenario := reportBudget.Scenarios[name]
+	base := totalScenario(reportBudget, period)
+
+	ReportBudget(scenarioBudget, period)
+	totals := totalScenario(scenarioBudget, period)
+
+	currency := reportBudget.ReportingCurrency()
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle(periodTitle(fmt.Sprintf("Base vs %s", name), period))
+	tableWriter.AppendHeader(table.Row{"", "Base", name, "Difference"})
+
+	for _, row := range []struct {
+		label          string
+		base, scenario quantity.Money
+		higherIsBetter bool
+	}{
+		{"Income", base.income, totals.income, true},
+		{"Expenses", base.expenses, totals.expenses, false},
+		{"Remaining", base.remaining, totals.remaining, true},
+	} {
+		difference := row.scenario - row.base
+		better := (difference > 0) == row.higherIsBetter
+		tableWriter.AppendRow(table.Row{
+			row.label,
+			row.base.Format(currency),
+			row.scenario.Format(currency),
+			scenarioDifference(signedMoney(difference, currency), difference != 0 && better, difference != 0 && !better),
+		})
+	}
+	difference := totals.savingsRate - base.savingsRate
+	differenceCell := difference.String()
+	if difference > 0 {
+		differenceCell = "+" + differenceCell
+	}
+	tableWriter.AppendRow(table.Row{
+		"Savings Rate",
+		base.savingsRate,
+		totals.savingsRate,
+		scenarioDifference(differenceCell, difference > 0, difference < 0),
+	})
+
+	fmt.Println()
+	fmt.Println(tableWriter.Render())
+	for _, change := range scenarioChanges(reportBudget, scenario) {
+		fmt.Println(text.Faint.Sprint("  " + change))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package reports
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const (
+	simulationBins     = 12   // Number of bins of the histogram of a simulation
+	simulationOutliers = 0.01 // Share of trials at either end left out of the histogram of a simulation
+	surplusSeries      = 5    // Index in chartPalette of bins of surpluses
+	deficitSeries      = 4    // Index in chartPalette of bins of deficits
+)
+
+// simulationPercentiles are the percentiles of the monthly surplus reported for a simulation
+var simulationPercentiles = []int{5, 10, 25, 50, 75, 90, 95}
+
+// ReportSimulation reports the percentiles of the monthly surplus of the trials of a simulation of a budget, a histogram of the
+// surpluses, and the probability of a deficit
+func ReportSimulation(reportBudget *budget.Budget, simulation budget.Simulation) {
+	currency := reportBudget.ReportingCurrency()
+
+	var varying []string
+	for _, name := range reportBudget.Income.SortedNames() {
+		if varies := reportBudget.Income[name].Attributes().Varies; varies != nil {
+			varying = append(varying, fmt.Sprintf("  %s (income): %s", name, describeDistribution(varies, reportBudget.Income[name].Attributes().Currency.Or(currency))))
+		}
+	}
+	for _, name := range reportBudget.Expenses.SortedNames() {
+		if varies := reportBudget.Expenses[name].Varies; varies != nil {
+			varying = append(varying, fmt.Sprintf("  %s (expense): %s", name, describeDistribution(varies, reportBudget.Expenses[name].Currency.Or(currency))))
+		}
+	}
+	if len(varying) == 0 {
+		fmt.Println(text.Faint.Sprint("No income sources or expenses vary, so every trial is the same; describe how they vary using \"budgetbuddy vary\"."))
+	} else {
+		fmt.Println(text.Bold.Sprint("Varying Income and Expenses"))
+		for _, line := range varying {
+			fmt.Println(line)
+		}
+	}
+	fmt.Println()
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle("Monthly Surplus")
+	tableWriter.AppendHeader(table.Row{"Percentile", "Surplus"})
+	for _, percentile := range simulationPercentiles {
+		tableWriter.AppendRow(table.Row{
+			quantity.MakeInteger(percentile).Ordinal(),
+			forecastMoney(simulation.Percentile(float64(percentile)/100), currency),
+		})
+	}
+	tableWriter.AppendFooter(table.Row{"Mean", forecastMoney(simulation.Mean(), currency)})
+	fmt.Println(tableWriter.Render())
+	fmt.Println()
+
+	reportSimulationHistogram(simulation, currency)
+	fmt.Println()
+
+	deficit := simulation.DeficitProbability()
+	message := fmt.Sprintf("Probability of a deficit: %s", deficit)
+	switch {
+	case deficit == 0:
+		fmt.Println(text.FgHiGreen.Sprint(message))
+	case deficit < 0.05:
+		fmt.Println(text.FgHiYellow.Sprint(message))
+	default:
+		fmt.Println(text.Colors{text.FgHiRed, text.Bold}.Sprint(message))
+	}
+	fmt.Println(text.Faint.Sprintf("%s trials from seed %d; give the same --seed to repeat them.", quantity.MakeInteger(len(simulation.Surpluses)), simulation.Seed))
+	reportMissingExchangeRates(reportBudget)
+}
+
+// describeDistribution describes a distribution in words
+func describeDistribution(distribution *budget.Distribution, currency quantity.Currency) string {
+	if distribution.Normal() {
+		return fmt.Sprintf("%s on average, give or take %s", distribution.Mean.Format(currency), distribution.StdDev.Format(currency))
+	}
+	return fmt.Sprintf("%s to %s, most likely %s", distribution.Min.Format(currency), distribution.Max.Format(currency), distribution.Likely.Format(currency))
+}
+
+// reportSimulationHistogram draws a histogram of the surpluses of a simulation, leaving out the most extreme trials at either end
+func reportSimulationHistogram(simulation budget.Simulation, currency quantity.Currency) {
+	canvas := newChartCanvas()
+	fmt.Println(canvas.title("Distribution of Monthly Surplus"))
+
+	low, high := simulation.Percentile(simulationOutliers), simulation.Percentile(1-simulationOutliers)
+	if high <= low {
+		fmt.Printf("Every trial has a surplus of about %s.\n", low.Format(currency))
+		return
+	}
+	width := (high - low) / simulationBins
+	counts := make([]int, simulationBins)
+	var largest int
+	for _, surplus := range simulation.Surpluses {
+		if surplus < low || surplus > high {
+			continue
+		}
+		bin := int((surplus - low) / width)
+		if bin >= simulationBins {
+			bin = simulationBins - 1
+		}
+		counts[bin]++
+		if counts[bin] > largest {
+			largest = counts[bin]
+		}
+	}
+
+	labels := make([]string, simulationBins)
+	var labelWidth int
+	for bin := range labels {
+		labels[bin] = fmt.Sprintf("%s to %s", (low + quantity.Money(bin)*width).Format(currency), (low + quantity.Money(bin+1)*width).Format(currency))
+		if length := text.RuneCount(labels[bin]); length > labelWidth {
+			labelWidth = length
+		}
+	}
+	barWidth := canvas.width - labelWidth - 8
+	if barWidth < 10 {
+		barWidth = 10
+	}
+	for bin, count := range counts {
+		series := surplusSeries
+		if low+quantity.Money(bin+1)*width <= 0 {
+			series = deficitSeries
+		}
+		share := quantity.Percentage(float64(count) / float64(len(simulation.Surpluses)))
+		fmt.Printf("%*s %s %6s\n",
+			labelWidth, labels[bin],
+			text.Pad(canvas.bar(series, float64(barWidth)*float64(count)/float64(largest)), barWidth, ' '),
+			share,
+		)
+	}
+}
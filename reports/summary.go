@@ -6,9 +6,10 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-func reportSummary(budget *budget.Budget) {
+func reportSummary(summaryBudget *budget.Budget, period quantity.Period) {
 	tableWriter := table.NewWriter()
 
 	tableWriter.SetColumnConfigs([]table.ColumnConfig{
@@ -30,9 +31,14 @@ func reportSummary(budget *budget.Budget) {
 	})
 	tableWriter.SetStyle(table.StyleColoredBright)
 
-	tableWriter.SetTitle("Summary")
+	tableWriter.SetTitle(periodTitle("Summary", period))
 	tableWriter.AppendHeader(table.Row{"Income", "Expenses", "Remaining"})
-	tableWriter.AppendRow(table.Row{budget.Income.Sum(), budget.Expenses.Sum(), budget.Sum()})
+	currency := summaryBudget.ReportingCurrency()
+	tableWriter.AppendRow(table.Row{
+		budget.PerPeriod(summaryBudget.Income.Sum(currency), period).Format(currency),
+		budget.PerPeriod(summaryBudget.Expenses.Sum(currency), period).Format(currency),
+		budget.PerPeriod(summaryBudget.Sum(), period).Format(currency),
+	})
 
 	fmt.Println(tableWriter.Render())
 }
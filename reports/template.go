@@ -0,0 +1,51 @@
+package reports
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+)
+
+// ReportTemplates lists named templates, with how many income sources and expenses each starts with
+func ReportTemplates(templates map[string]*budget.Template) {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:           2,
+			WidthMax:         50,
+			WidthMaxEnforcer: text.WrapSoft,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+	tableWriter.SetTitle("Templates")
+	tableWriter.AppendHeader(table.Row{"Name", "Description", "Income", "Expenses", "Source"})
+	for _, name := range names {
+		template := templates[name]
+		source := "saved"
+		if template.Builtin() {
+			source = "built-in"
+		}
+		tableWriter.AppendRow(table.Row{name, template.Description, len(template.Income), len(template.Expenses), source})
+	}
+	fmt.Println(tableWriter.Render())
+	fmt.Println(text.Faint.Sprint("Create a budget from a template using \"budgetbuddy create --template <name>\"."))
+}
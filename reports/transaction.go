@@ -0,0 +1,71 @@
+package reports
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// ReportTransactions lists the given transactions, in the given currency, under the given title
+func ReportTransactions(title string, transactions []*budget.Transaction, currency quantity.Currency) {
+	tableWriter := table.NewWriter()
+
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    30,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    30,
+		},
+		{
+			Number:      5,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    30,
+		},
+		{
+			Number:      6,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+			WidthMin:    15,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(title)
+	tableWriter.AppendHeader(table.Row{"ID", "Date", "Payee", "Category", "Memo", "Amount"})
+	var total quantity.Money
+	for _, transaction := range transactions {
+		tableWriter.AppendRow(table.Row{
+			transaction.ID,
+			transaction.Date.Format(budget.DateLayout),
+			transaction.Payee,
+			transaction.Category,
+			transaction.Memo,
+			transaction.Amount.Format(currency),
+		})
+		total += transaction.Amount
+	}
+	tableWriter.AppendFooter(table.Row{"", "", "", "", "Total", total.Format(currency)})
+
+	fmt.Println(tableWriter.Render())
+}
@@ -0,0 +1,216 @@
+package reports
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// variance describes how an actual amount compares to a planned amount
+type variance struct {
+	planned quantity.Money
+	actual  quantity.Money
+}
+
+// difference returns the actual amount less the planned amount
+func (v variance) difference() quantity.Money {
+	return v.actual - v.planned
+}
+
+// percentage returns the difference as a portion of the planned amount
+func (v variance) percentage() quantity.Percentage {
+	if v.planned == 0 {
+		return quantity.Percentage(math.NaN())
+	}
+	return quantity.Percentage(v.difference().ValueOf() / v.planned.ValueOf())
+}
+
+// flag returns a colored flag when the actual amount is unfavorable compared to the planned amount.
+// Spending more than planned is unfavorable for expenses, while receiving less than planned is unfavorable for income.
+func (v variance) flag(isExpense bool) string {
+	difference := v.difference()
+	if difference == 0 {
+		return ""
+	}
+
+	label := "UNDER"
+	if difference > 0 {
+		label = "OVER"
+	}
+	if (difference > 0) == isExpense {
+		return text.Colors{text.FgHiRed, text.Bold}.Sprint(label)
+	}
+	return text.Colors{text.FgHiGreen, text.Bold}.Sprint(label)
+}
+
+// varianceRow describes the variances of an expense or income source for a month and year-to-date
+type varianceRow struct {
+	month      variance
+	yearToDate variance
+}
+
+// add accumulates another row into the row
+func (row *varianceRow) add(other varianceRow) {
+	row.month.planned += other.month.planned
+	row.month.actual += other.month.actual
+	row.yearToDate.planned += other.yearToDate.planned
+	row.yearToDate.actual += other.yearToDate.actual
+}
+
+// plannedRow returns the variances of the actual totals of a month and year-to-date from a monthly amount planned by the budget,
+// converted into the given currency as of each month, since transactions are recorded as they were received or paid
+func plannedRow(planned quantity.Amount, currency quantity.Currency, month time.Time, monthActual, yearActual quantity.Money) varianceRow {
+	row := varianceRow{
+		month:      variance{planned: budget.Convert(planned.Money, planned.Currency, currency, month), actual: monthActual},
+		yearToDate: variance{actual: yearActual},
+	}
+	for yearMonth := time.Date(month.Year(), time.January, 1, 0, 0, 0, 0, month.Location()); !yearMonth.After(month); yearMonth = yearMonth.AddDate(0, 1, 0) {
+		row.yearToDate.planned += budget.Convert(planned.Money, planned.Currency, currency, yearMonth)
+	}
+	return row
+}
+
+// ReportVariance compares the planned amounts of a budget to the actual transactions recorded in its journal
+// during the month starting at the given date, and year-to-date through that month.
+func ReportVariance(reportBudget *budget.Budget, journal *budget.Journal, month time.Time) {
+	monthEnd := month.AddDate(0, 1, 0)
+	yearStart := time.Date(month.Year(), time.January, 1, 0, 0, 0, 0, month.Location())
+
+	currency := reportBudget.ReportingCurrency()
+	monthTotals := journal.Totals(reportBudget, month, monthEnd)
+	yearTotals := journal.Totals(reportBudget, yearStart, monthEnd)
+
+	incomeVariances := make(map[string]varianceRow)
+	for _, name := range reportBudget.Income.SortedNames() {
+		incomeVariances[name] = plannedRow(reportBudget.Income.Amount(name, currency), currency, month, monthTotals[name], yearTotals[name])
+	}
+	reportVarianceList(fmt.Sprintf("Income for %s", month.Format(budget.MonthLayout)), reportBudget.Income.SortedNames(), incomeVariances, currency, false)
+	fmt.Println()
+
+	expenseVariances := make(map[string]varianceRow)
+	for _, name := range reportBudget.Expenses.SortedNames() {
+		expenseVariances[name] = plannedRow(reportBudget.Expenses.Amount(name, currency), currency, month, monthTotals[name], yearTotals[name])
+	}
+	reportVarianceList(fmt.Sprintf("Expenses for %s", month.Format(budget.MonthLayout)), reportBudget.Expenses.SortedNames(), expenseVariances, currency, true)
+	fmt.Println()
+
+	reportVarianceSummary(month, incomeVariances, expenseVariances, currency)
+
+	var unlinked int
+	for _, transaction := range journal.Between(month, monthEnd) {
+		_, isIncome := reportBudget.Income[transaction.Category]
+		_, isExpense := reportBudget.Expenses[transaction.Category]
+		if !isIncome && !isExpense {
+			unlinked++
+		}
+	}
+	if unlinked > 0 {
+		fmt.Println()
+		fmt.Println(text.Faint.Sprintf("%d transaction(s) in %s are not linked to an expense or income source.", unlinked, month.Format(budget.MonthLayout)))
+	}
+}
+
+func reportVarianceList(title string, names []string, variances map[string]varianceRow, currency quantity.Currency, isExpense bool) {
+	tableWriter := table.NewWriter()
+
+	columnConfigs := []table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+			WidthMax:    40,
+		},
+	}
+	for number := 2; number <= 9; number++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{
+			Number:      number,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		})
+	}
+	tableWriter.SetColumnConfigs(columnConfigs)
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(title)
+	tableWriter.AppendHeader(table.Row{"Name", "Planned", "Actual", "Variance", "Variance %", "", "YTD Planned", "YTD Actual", "YTD Variance"})
+	var total varianceRow
+	for _, name := range names {
+		row := variances[name]
+		tableWriter.AppendRow(varianceTableRow(name, row, currency, isExpense))
+		total.add(row)
+	}
+	tableWriter.AppendFooter(varianceTableRow("Total", total, currency, isExpense))
+
+	fmt.Println(tableWriter.Render())
+}
+
+func varianceTableRow(label string, row varianceRow, currency quantity.Currency, isExpense bool) table.Row {
+	return table.Row{
+		label,
+		row.month.planned.Format(currency),
+		row.month.actual.Format(currency),
+		row.month.difference().Format(currency),
+		row.month.percentage(),
+		row.month.flag(isExpense),
+		row.yearToDate.planned.Format(currency),
+		row.yearToDate.actual.Format(currency),
+		row.yearToDate.difference().Format(currency),
+	}
+}
+
+func reportVarianceSummary(month time.Time, incomeVariances, expenseVariances map[string]varianceRow, currency quantity.Currency) {
+	var income, expenses varianceRow
+	for _, row := range incomeVariances {
+		income.add(row)
+	}
+	for _, row := range expenseVariances {
+		expenses.add(row)
+	}
+
+	tableWriter := table.NewWriter()
+
+	tableWriter.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number:      1,
+			Align:       text.AlignLeft,
+			AlignHeader: text.AlignLeft,
+		},
+		{
+			Number:      2,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      3,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+		{
+			Number:      4,
+			Align:       text.AlignRight,
+			AlignHeader: text.AlignRight,
+		},
+	})
+	tableWriter.SetStyle(table.StyleColoredBright)
+
+	tableWriter.SetTitle(fmt.Sprintf("Summary for %s", month.Format(budget.MonthLayout)))
+	tableWriter.AppendHeader(table.Row{"", "Income", "Expenses", "Remaining"})
+	for _, row := range []struct {
+		label            string
+		income, expenses quantity.Money
+	}{
+		{"Planned", income.month.planned, expenses.month.planned},
+		{"Actual", income.month.actual, expenses.month.actual},
+		{"YTD Planned", income.yearToDate.planned, expenses.yearToDate.planned},
+		{"YTD Actual", income.yearToDate.actual, expenses.yearToDate.actual},
+	} {
+		tableWriter.AppendRow(table.Row{row.label, row.income.Format(currency), row.expenses.Format(currency), (row.income - row.expenses).Format(currency)})
+	}
+
+	fmt.Println(tableWriter.Render())
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"math"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// reportLine describes a named amount of a report, in the currency of the budget and, if it differs, in its original currency.
+// Amounts that cannot be converted into the currency of the budget are null.
+type reportLine struct {
+	Name             string            `json:"name"`
+	Amount           *float64          `json:"amount"`
+	Original         *float64          `json:"original,omitempty"`
+	OriginalCurrency quantity.Currency `json:"original_currency,omitempty"`
+}
+
+// reportHealth describes the financial health metrics of a report. Metrics that cannot be calculated, such as the debt ratio of a
+// budget without debts, are left out.
+type reportHealth struct {
+	SavingsRate         *float64 `json:"savings_rate,omitempty"`
+	ExpenseRatio        *float64 `json:"expense_ratio,omitempty"`
+	DebtRatio           *float64 `json:"debt_ratio,omitempty"`
+	HousingRatio        *float64 `json:"housing_ratio,omitempty"`
+	EmergencyFundMonths *float64 `json:"emergency_fund_months,omitempty"`
+	FixedExpenseRatio   *float64 `json:"fixed_expense_ratio,omitempty"`
+}
+
+// report describes the report of a budget, with the same amounts as reports.ReportBudget
+type report struct {
+	Name     string            `json:"name"`
+	Currency quantity.Currency `json:"currency"`
+	Period   string            `json:"period"`
+	Income   []reportLine      `json:"income"`
+	Expenses []reportLine      `json:"expenses"`
+	Summary  struct {
+		Income    *float64 `json:"income"`
+		Expenses  *float64 `json:"expenses"`
+		Remaining *float64 `json:"remaining"`
+	} `json:"summary"`
+	Health   reportHealth `json:"health"`
+	Warnings []string     `json:"warnings,omitempty"`
+}
+
+// finite returns an amount or metric, unless it could not be calculated
+func finite(value float64) *float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+	return &value
+}
+
+// makeReport reports the income and expenses of a budget rescaled to the given period. Financial health metrics are always monthly.
+func makeReport(name string, reportBudget *budget.Budget, period quantity.Period) *report {
+	currency := reportBudget.ReportingCurrency()
+	r := &report{
+		Name:     name,
+		Currency: currency,
+		Period:   period.String(),
+		Income:   []reportLine{},
+		Expenses: []reportLine{},
+	}
+
+	for _, incomeName := range reportBudget.Income.SortedNames() {
+		income := reportBudget.Income[incomeName]
+		line := reportLine{Name: incomeName, Amount: finite(budget.PerPeriod(reportBudget.Income.Converted(incomeName, currency), period).ValueOf())}
+		if original := income.Attributes().Currency.Or(currency); original != currency {
+			line.Original = finite(budget.PerPeriod(income.MonthlyIncome(), period).ValueOf())
+			line.OriginalCurrency = original
+		}
+		r.Income = append(r.Income, line)
+	}
+	for _, expenseName := range reportBudget.Expenses.SortedNames() {
+		expense := reportBudget.Expenses[expenseName]
+		line := reportLine{Name: expenseName, Amount: finite(budget.PerPeriod(reportBudget.Expenses.Converted(expenseName, currency), period).ValueOf())}
+		if original := expense.Currency.Or(currency); original != currency {
+			line.Original = finite(budget.PerPeriod(expense.MonthlyExpense(), period).ValueOf())
+			line.OriginalCurrency = original
+		}
+		r.Expenses = append(r.Expenses, line)
+	}
+
+	r.Summary.Income = finite(budget.PerPeriod(reportBudget.Income.Sum(currency), period).ValueOf())
+	r.Summary.Expenses = finite(budget.PerPeriod(reportBudget.Expenses.Sum(currency), period).ValueOf())
+	r.Summary.Remaining = finite(budget.PerPeriod(reportBudget.Sum(), period).ValueOf())
+
+	health := reportBudget.Health()
+	r.Health.SavingsRate = finite(health.SavingsRate().ValueOf())
+	r.Health.ExpenseRatio = finite(health.ExpenseRatio().ValueOf())
+	if health.Debt > 0 {
+		r.Health.DebtRatio = finite(health.DebtRatio().ValueOf())
+	}
+	if health.Housing > 0 {
+		r.Health.HousingRatio = finite(health.HousingRatio().ValueOf())
+	}
+	if health.Balance > 0 {
+		r.Health.EmergencyFundMonths = finite(health.EmergencyFundMonths().ValueOf())
+	}
+	if health.Fixed > 0 {
+		r.Health.FixedExpenseRatio = finite(health.FixedExpenseRatio().ValueOf())
+	}
+
+	for _, err := range reportBudget.MissingExchangeRates() {
+		r.Warnings = append(r.Warnings, err.Error())
+	}
+	return r
+}
@@ -0,0 +1,276 @@
+// Package server implements a local HTTP JSON API over the budgets in a directory
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// budgetExtension is the extension of budget files
+const budgetExtension = ".budget"
+
+// maxRequestSize is the largest body of a request that is read, in bytes
+const maxRequestSize = 1 << 20
+
+// Server serves the budgets saved in a directory over HTTP, as JSON:
+//
+//	GET    /budgets                      lists the budgets with their monthly totals
+//	GET    /budgets/{name}               gets a budget, as it is saved
+//	POST   /budgets/{name}               creates a budget
+//	PUT    /budgets/{name}               replaces a budget
+//	DELETE /budgets/{name}               deletes a budget
+//	GET    /budgets/{name}/report        reports on a budget, rescaled to the period given by ?period=, such as weekly
+//
+// Budgets are sent as they are saved, except that quantities may also be written as they are answered in surveys, as strings such as
+// "$1,200.50", "6%" or, for expenses, "$300/quarter". Budgets are locked while they are read or written, so requests may be served
+// concurrently.
+type Server struct {
+	dir   string
+	mutex sync.RWMutex
+}
+
+// New makes a server of the budgets saved in the given directory
+func New(dir string) *Server {
+	return &Server{dir: dir}
+}
+
+// errorResponse describes an error sent in response to a request. Invalid fields of a budget are named by their path, such as
+// "income.job.rate".
+type errorResponse struct {
+	Error  string            `json:"error"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// budgetSummary describes a budget in the list of budgets, with its monthly totals in the currency of the budget
+type budgetSummary struct {
+	Name      string            `json:"name"`
+	Currency  quantity.Currency `json:"currency"`
+	Income    *float64          `json:"income"`
+	Expenses  *float64          `json:"expenses"`
+	Remaining *float64          `json:"remaining"`
+}
+
+// writeJSON writes a value as the JSON body of a response with the given status
+func writeJSON(writer http.ResponseWriter, status int, value interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "\t")
+	encoder.Encode(value)
+}
+
+// writeError writes an error as the JSON body of a response with the given status
+func writeError(writer http.ResponseWriter, status int, err error) {
+	var invalid validationError
+	if errors.As(err, &invalid) {
+		writeJSON(writer, status, errorResponse{Error: "invalid budget", Fields: invalid})
+		return
+	}
+	writeJSON(writer, status, errorResponse{Error: err.Error()})
+}
+
+// validName reports whether a budget may be named as given, without leaving the directory of budgets
+func validName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, ".") && !strings.ContainsAny(name, `/\`) && filepath.Base(name) == name
+}
+
+// path returns the path of the file of a named budget
+func (server *Server) path(name string) string {
+	return filepath.Join(server.dir, name+budgetExtension)
+}
+
+// ServeHTTP implements http.Handler for Server
+func (server *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	parts := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	if parts[0] != "budgets" || len(parts) > 3 || (len(parts) == 3 && parts[2] != "report") {
+		writeError(writer, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	if len(parts) == 1 {
+		if request.Method != http.MethodGet {
+			writeError(writer, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", request.Method))
+			return
+		}
+		server.list(writer)
+		return
+	}
+
+	name := parts[1]
+	if !validName(name) {
+		writeError(writer, http.StatusBadRequest, fmt.Errorf("invalid budget name %q", name))
+		return
+	}
+
+	if len(parts) == 3 {
+		if request.Method != http.MethodGet {
+			writeError(writer, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", request.Method))
+			return
+		}
+		server.report(writer, request, name)
+		return
+	}
+
+	switch request.Method {
+	case http.MethodGet:
+		server.get(writer, name)
+	case http.MethodPost:
+		server.put(writer, request, name, true)
+	case http.MethodPut:
+		server.put(writer, request, name, false)
+	case http.MethodDelete:
+		server.delete(writer, name)
+	default:
+		writeError(writer, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", request.Method))
+	}
+}
+
+// load loads a named budget, responding with an error if it cannot be. The caller must hold the lock.
+func (server *Server) load(writer http.ResponseWriter, name string) (*budget.Budget, bool) {
+	loaded, err := budget.LoadFile(name, server.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		writeError(writer, http.StatusNotFound, fmt.Errorf("no budget named %q", name))
+		return nil, false
+	} else if err != nil {
+		writeError(writer, http.StatusInternalServerError, fmt.Errorf("could not load budget %q: %w", name, err))
+		return nil, false
+	}
+	return loaded, true
+}
+
+// list responds with the budgets in the directory, sorted by name
+func (server *Server) list(writer http.ResponseWriter) {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+
+	entries, err := os.ReadDir(server.dir)
+	if err != nil {
+		writeError(writer, http.StatusInternalServerError, err)
+		return
+	}
+	var names []string
+	for _, entry := range entries {
+		if name := strings.TrimSuffix(entry.Name(), budgetExtension); !entry.IsDir() && name != entry.Name() && validName(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	summaries := make([]budgetSummary, 0, len(names))
+	for _, name := range names {
+		listed, err := budget.LoadFile(name, server.path(name))
+		if err != nil {
+			writeError(writer, http.StatusInternalServerError, fmt.Errorf("could not load budget %q: %w", name, err))
+			return
+		}
+		currency := listed.ReportingCurrency()
+		summaries = append(summaries, budgetSummary{
+			Name:      name,
+			Currency:  currency,
+			Income:    finite(listed.Income.Sum(currency).ValueOf()),
+			Expenses:  finite(listed.Expenses.Sum(currency).ValueOf()),
+			Remaining: finite(listed.Sum().ValueOf()),
+		})
+	}
+	writeJSON(writer, http.StatusOK, summaries)
+}
+
+// get responds with a named budget, as it is saved
+func (server *Server) get(writer http.ResponseWriter, name string) {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+
+	if loaded, ok := server.load(writer, name); ok {
+		writeJSON(writer, http.StatusOK, loaded)
+	}
+}
+
+// put creates or replaces a named budget with the one sent in the request, and responds with it as it was saved. Budgets are only
+// created if they do not exist, and only replaced if they do.
+func (server *Server) put(writer http.ResponseWriter, request *http.Request, name string, create bool) {
+	data, err := io.ReadAll(io.LimitReader(request.Body, maxRequestSize))
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	sent, err := decodeBudget(name, data)
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	_, err = os.Stat(server.path(name))
+	switch {
+	case create && err == nil:
+		writeError(writer, http.StatusConflict, fmt.Errorf("budget %q already exists", name))
+		return
+	case !create && errors.Is(err, os.ErrNotExist):
+		writeError(writer, http.StatusNotFound, fmt.Errorf("no budget named %q", name))
+		return
+	}
+
+	// Write to a temporary file first, so a budget is never left half-written
+	temporary := server.path(name) + ".tmp"
+	if err := sent.SaveFile(temporary); err != nil {
+		writeError(writer, http.StatusInternalServerError, fmt.Errorf("could not save budget %q: %w", name, err))
+		return
+	}
+	if err := os.Rename(temporary, server.path(name)); err != nil {
+		os.Remove(temporary)
+		writeError(writer, http.StatusInternalServerError, fmt.Errorf("could not save budget %q: %w", name, err))
+		return
+	}
+
+	if create {
+		writeJSON(writer, http.StatusCreated, sent)
+	} else {
+		writeJSON(writer, http.StatusOK, sent)
+	}
+}
+
+// delete deletes a named budget
+func (server *Server) delete(writer http.ResponseWriter, name string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if err := os.Remove(server.path(name)); errors.Is(err, os.ErrNotExist) {
+		writeError(writer, http.StatusNotFound, fmt.Errorf("no budget named %q", name))
+	} else if err != nil {
+		writeError(writer, http.StatusInternalServerError, err)
+	} else {
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// report responds with a report on a named budget, rescaled to the period given by the period query parameter, if any
+func (server *Server) report(writer http.ResponseWriter, request *http.Request, name string) {
+	period := quantity.Month
+	if periodValue := request.URL.Query().Get("period"); periodValue != "" {
+		var err error
+		if period, err = quantity.NewCalendarPeriod(periodValue); err != nil {
+			writeError(writer, http.StatusBadRequest, fmt.Errorf("invalid period %q: expected a period such as weekly, biweekly, semimonthly, monthly, quarterly or annual", periodValue))
+			return
+		}
+	}
+
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+
+	if loaded, ok := server.load(writer, name); ok {
+		writeJSON(writer, http.StatusOK, makeReport(name, loaded, period))
+	}
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const testBudget = `{
+	"income": {
+		"job": {"rate": "$20.00", "hours": 40},
+		"side": {"money": 300}
+	},
+	"expenses": {
+		"rent": {"amount": "$3,600/quarter", "kind": "housing", "fixed": true},
+		"food": 400
+	},
+	"savings": "$5,000"
+}`
+
+func init() {
+	budget.MinimumWage = 7.25
+	budget.MinimumOvertimeHours = 40
+	budget.NetPayPercentage = 0.75
+}
+
+// newTestServer makes a server of the budgets in a temporary directory
+func newTestServer(t *testing.T) (*httptest.Server, string) {
+	dir := t.TempDir()
+	testServer := httptest.NewServer(New(dir))
+	t.Cleanup(testServer.Close)
+	return testServer, dir
+}
+
+// do sends a request to a test server and decodes the JSON body of its response, if any, into the given value. This fails the test
+// immediately if the request cannot be made, so it must only be called from the goroutine running the test; see send.
+func do(t *testing.T, testServer *httptest.Server, method string, path string, body string, value interface{}) int {
+	t.Helper()
+	status, err := send(testServer, method, path, body, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status
+}
+
+// send sends a request to a test server and decodes the JSON body of its response, if any, into the given value
+func send(testServer *httptest.Server, method string, path string, body string, value interface{}) (int, error) {
+	request, err := http.NewRequest(method, testServer.URL+path, strings.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	response, err := testServer.Client().Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	if value != nil {
+		if err := json.NewDecoder(response.Body).Decode(value); err != nil {
+			return response.StatusCode, fmt.Errorf("%s %s: could not decode response: %w", method, path, err)
+		}
+	}
+	return response.StatusCode, nil
+}
+
+func TestCreateGetAndList(t *testing.T) {
+	testServer, dir := newTestServer(t)
+
+	if status := do(t, testServer, http.MethodPost, "/budgets/home", testBudget, nil); status != http.StatusCreated {
+		t.Fatalf("create: got status %d, want %d", status, http.StatusCreated)
+	}
+	if status := do(t, testServer, http.MethodPost, "/budgets/home", testBudget, nil); status != http.StatusConflict {
+		t.Errorf("create again: got status %d, want %d", status, http.StatusConflict)
+	}
+
+	saved, err := budget.LoadFile("home", filepath.Join(dir, "home.budget"))
+	if err != nil {
+		t.Fatalf("could not load saved budget: %s", err)
+	}
+	wages := saved.Income["job"].(*budget.Wages)
+	if wages.Rate != 20 || wages.Hours != 40 {
+		t.Errorf("saved wages: got %v per hour for %v hours, want 20 per hour for 40 hours", wages.Rate, wages.Hours)
+	}
+	if rent := saved.Expenses["rent"]; rent.Amount != 1200 || rent.Kind != budget.HousingKind || !rent.Fixed {
+		t.Errorf("saved rent: got %+v, want 1200 per month, fixed housing", rent)
+	}
+	if saved.Savings != 5000 {
+		t.Errorf("saved savings: got %v, want 5000", saved.Savings)
+	}
+
+	var got map[string]interface{}
+	if status := do(t, testServer, http.MethodGet, "/budgets/home", "", &got); status != http.StatusOK {
+		t.Fatalf("get: got status %d, want %d", status, http.StatusOK)
+	}
+	if food := got["expenses"].(map[string]interface{})["food"]; food != 400.0 {
+		t.Errorf("get: got food %v, want 400", food)
+	}
+
+	var list []budgetSummary
+	if status := do(t, testServer, http.MethodGet, "/budgets", "", &list); status != http.StatusOK {
+		t.Fatalf("list: got status %d, want %d", status, http.StatusOK)
+	}
+	if len(list) != 1 || list[0].Name != "home" || *list[0].Remaining != saved.Sum().ValueOf() {
+		t.Errorf("list: got %+v, want home with %v remaining", list, saved.Sum())
+	}
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	testServer, _ := newTestServer(t)
+
+	if status := do(t, testServer, http.MethodPut, "/budgets/home", testBudget, nil); status != http.StatusNotFound {
+		t.Errorf("update missing: got status %d, want %d", status, http.StatusNotFound)
+	}
+	do(t, testServer, http.MethodPost, "/budgets/home", testBudget, nil)
+
+	var updated map[string]interface{}
+	if status := do(t, testServer, http.MethodPut, "/budgets/home", `{"income": {"pay": {"salary": "$60,000"}}, "expenses": {"rent": "$1,500"}}`, &updated); status != http.StatusOK {
+		t.Fatalf("update: got status %d, want %d", status, http.StatusOK)
+	}
+	if _, kept := updated["income"].(map[string]interface{})["job"]; kept {
+		t.Error("update: income source left out of the update was kept")
+	}
+
+	if status := do(t, testServer, http.MethodDelete, "/budgets/home", "", nil); status != http.StatusNoContent {
+		t.Errorf("delete: got status %d, want %d", status, http.StatusNoContent)
+	}
+	if status := do(t, testServer, http.MethodGet, "/budgets/home", "", nil); status != http.StatusNotFound {
+		t.Errorf("get deleted: got status %d, want %d", status, http.StatusNotFound)
+	}
+	if status := do(t, testServer, http.MethodDelete, "/budgets/home", "", nil); status != http.StatusNotFound {
+		t.Errorf("delete again: got status %d, want %d", status, http.StatusNotFound)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	testServer, dir := newTestServer(t)
+
+	for _, test := range []struct {
+		body  string
+		field string
+	}{
+		{`{"income": {"job": {"rate": "$1.00", "hours": 40}}, "expenses": {}}`, "income.job.rate"},
+		{`{"income": {"job": {"rate": "$20.00", "hours": "lots"}}, "expenses": {}}`, "income.job.hours"},
+		{`{"income": {"shop": {"rate": "$5.00", "items": 2.5}}, "expenses": {}}`, "income.shop.items"},
+		{`{"income": {"realtor": {"rate": "six", "volume": ["$250,000"]}}, "expenses": {}}`, "income.realtor.rate"},
+		{`{"income": {"realtor": {"rate": "6%", "volume": ["$250,000", "free"]}}, "expenses": {}}`, "income.realtor.volume.1"},
+		{`{"income": {"gift": {"amount": 5}}, "expenses": {}}`, "income.gift"},
+		{`{"income": {}, "expenses": {"rent": "$0.00"}}`, "expenses.rent.amount"},
+		{`{"income": {}, "expenses": {"rent": "$1,200 per blue moon"}}`, "expenses.rent.amount"},
+		{`{"income": {}, "expenses": {"cleaner": "$25/hour"}}`, "expenses.cleaner.amount"},
+		{`{"income": {}, "expenses": {"rent": {"amount": "€1,200", "currency": "USD"}}}`, "expenses.rent.amount"},
+		{`{"currency": "XYZ", "income": {}, "expenses": {}}`, "currency"},
+	} {
+		var response errorResponse
+		if status := do(t, testServer, http.MethodPost, "/budgets/invalid", test.body, &response); status != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, want %d", test.body, status, http.StatusBadRequest)
+			continue
+		}
+		if _, found := response.Fields[test.field]; !found {
+			t.Errorf("%s: got invalid fields %v, want %s", test.body, response.Fields, test.field)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "invalid.budget")); err == nil {
+		t.Error("an invalid budget was saved")
+	}
+
+	if status := do(t, testServer, http.MethodPost, "/budgets/..", testBudget, nil); status == http.StatusCreated {
+		t.Error("a budget was created outside of the directory")
+	}
+	if status := do(t, testServer, http.MethodPost, "/budgets/home", `not json`, nil); status != http.StatusBadRequest {
+		t.Errorf("malformed body: got status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestReport(t *testing.T) {
+	testServer, dir := newTestServer(t)
+	do(t, testServer, http.MethodPost, "/budgets/home", testBudget, nil)
+	saved, err := budget.LoadFile("home", filepath.Join(dir, "home.budget"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, period := range []quantity.Period{quantity.Month, quantity.Week, quantity.Year} {
+		var got report
+		if status := do(t, testServer, http.MethodGet, fmt.Sprintf("/budgets/home/report?period=%s", period), "", &got); status != http.StatusOK {
+			t.Fatalf("report per %s: got status %d, want %d", period, status, http.StatusOK)
+		}
+
+		currency := saved.ReportingCurrency()
+		if want := budget.PerPeriod(saved.Sum(), period).ValueOf(); *got.Summary.Remaining != want {
+			t.Errorf("report per %s: got %v remaining, want %v", period, *got.Summary.Remaining, want)
+		}
+		if want := budget.PerPeriod(saved.Income.Converted("job", currency), period).ValueOf(); len(got.Income) != 2 || *got.Income[0].Amount != want {
+			t.Errorf("report per %s: got income %+v, want job first with %v", period, got.Income, want)
+		}
+		if want := saved.Health().HousingRatio().ValueOf(); got.Health.HousingRatio == nil || *got.Health.HousingRatio != want {
+			t.Errorf("report per %s: got housing ratio %v, want %v", period, got.Health.HousingRatio, want)
+		}
+		if got.Health.DebtRatio != nil {
+			t.Errorf("report per %s: got debt ratio %v for a budget without debts", period, *got.Health.DebtRatio)
+		}
+	}
+
+	if status := do(t, testServer, http.MethodGet, "/budgets/home/report?period=sometimes", "", nil); status != http.StatusBadRequest {
+		t.Errorf("invalid period: got status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	testServer, _ := newTestServer(t)
+	do(t, testServer, http.MethodPost, "/budgets/home", testBudget, nil)
+
+	var wait sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wait.Add(1)
+		go func(worker int) {
+			defer wait.Done()
+			for request := 0; request < 20; request++ {
+				// Workers cannot stop the test with t.Fatal, so they report errors and stop themselves
+				body := fmt.Sprintf(`{"income": {"pay": {"money": %d}}, "expenses": {"rent": 1000}}`, 1000+worker)
+				if status, err := send(testServer, http.MethodPut, "/budgets/home", body, nil); err != nil {
+					t.Errorf("update: %s", err)
+					return
+				} else if status != http.StatusOK {
+					t.Errorf("update: got status %d, want %d", status, http.StatusOK)
+				}
+				var got report
+				if status, err := send(testServer, http.MethodGet, "/budgets/home/report", "", &got); err != nil {
+					t.Errorf("report: %s", err)
+					return
+				} else if status != http.StatusOK {
+					t.Errorf("report: got status %d, want %d", status, http.StatusOK)
+				}
+			}
+		}(worker)
+	}
+	wait.Wait()
+}
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// validationError describes the fields of a budget sent in a request that are invalid, by their path, such as "income.job.rate"
+type validationError map[string]string
+
+// Error implements error for validationError
+func (err validationError) Error() string {
+	paths := make([]string, 0, len(err))
+	for path := range err {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return fmt.Sprintf("%s: %s", paths[0], err[paths[0]])
+}
+
+// validator validates and normalises the quantities of a budget sent in a request. Quantities may be written as they are saved,
+// as plain numbers, or as they are answered in surveys, as strings such as "$1,200.50", "6%" or "$300/quarter".
+type validator struct {
+	currency quantity.Currency // Currency of the budget
+	err      validationError
+}
+
+// fail records that the field at the given path is invalid
+func (v *validator) fail(path string, format string, args ...interface{}) {
+	if _, failed := v.err[path]; !failed {
+		v.err[path] = fmt.Sprintf(format, args...)
+	}
+}
+
+// currencyOf validates and normalises the currency of the object at the given path, if any, to its ISO 4217 code
+func (v *validator) currencyOf(path string, object map[string]interface{}) quantity.Currency {
+	value, given := object["currency"]
+	if !given || value == nil {
+		return ""
+	}
+	currency, err := quantity.NewCurrency(value)
+	if err != nil {
+		if path != "" {
+			path += "."
+		}
+		v.fail(path+"currency", "not a known currency")
+		return ""
+	}
+	object["currency"] = currency
+	return currency
+}
+
+// money validates and normalises an amount of money at the given path, which must be at least the given minimum. Amounts written in
+// a currency must be in the given currency, which is set to it if it is empty.
+func (v *validator) money(path string, value interface{}, currency *quantity.Currency, minimum quantity.Money) float64 {
+	var money quantity.Money
+	switch moneyValue := value.(type) {
+	case float64:
+		money = quantity.Money(moneyValue)
+	case string:
+		parsed, parsedCurrency, err := quantity.ParseMoney(moneyValue)
+		if err != nil {
+			v.fail(path, "not a monetary value, such as $25.00")
+			return math.NaN()
+		}
+		if parsedCurrency != "" {
+			if *currency == "" {
+				*currency = parsedCurrency
+			} else if parsedCurrency != *currency {
+				v.fail(path, "written in %s, but paid in %s", parsedCurrency, *currency)
+				return math.NaN()
+			}
+		}
+		money = parsed
+	default:
+		v.fail(path, "not a monetary value, such as $25.00")
+		return math.NaN()
+	}
+	if money < minimum {
+		v.fail(path, "must be at least %s", minimum.Format(currency.Or(v.currency)))
+	}
+	return money.ValueOf()
+}
+
+// number validates and normalises a number at the given path, which must be at least the given minimum
+func (v *validator) number(path string, value interface{}, minimum quantity.Number) float64 {
+	number, err := quantity.NewNumber(value)
+	if value == nil || err != nil {
+		v.fail(path, "not a number")
+		return math.NaN()
+	}
+	if number < minimum {
+		v.fail(path, "must be at least %s", minimum)
+	}
+	return number.ValueOf()
+}
+
+// integer validates and normalises a whole number at the given path, which must be at least the given minimum
+func (v *validator) integer(path string, value interface{}, minimum quantity.Integer) float64 {
+	if number, ok := value.(float64); ok {
+		if number != math.Trunc(number) {
+			v.fail(path, "not a whole number")
+			return math.NaN()
+		}
+		value = int(number)
+	}
+	integer, err := quantity.NewInteger(value)
+	if value == nil || err != nil {
+		v.fail(path, "not a whole number")
+		return math.NaN()
+	}
+	if integer < minimum {
+		v.fail(path, "must be at least %s", minimum)
+	}
+	return integer.ValueOf()
+}
+
+// percentage validates and normalises a percentage at the given path, which must be at least the given minimum. Plain numbers are
+// fractions, as saved, so 0.06 is 6%.
+func (v *validator) percentage(path string, value interface{}, minimum quantity.Percentage) float64 {
+	var percentage quantity.Percentage
+	switch percentageValue := value.(type) {
+	case float64:
+		percentage = quantity.Percentage(percentageValue)
+	case string:
+		parsed, err := quantity.NewPercentage(percentageValue)
+		if err != nil {
+			v.fail(path, "not a percentage, such as 6%%")
+			return math.NaN()
+		}
+		percentage = parsed
+	default:
+		v.fail(path, "not a percentage, such as 6%%")
+		return math.NaN()
+	}
+	if percentage < minimum {
+		v.fail(path, "must be at least %s", minimum)
+	}
+	return percentage.ValueOf()
+}
+
+// income validates and normalises the amounts of an income source, with the same bounds as the surveys
+func (v *validator) income(path string, income map[string]interface{}) {
+	currency := v.currencyOf(path, income)
+	explicit := currency != ""
+
+	_, hasHours := income["hours"]
+	_, hasItems := income["items"]
+	_, hasVolume := income["volume"]
+	switch {
+	case hasHours:
+		income["rate"] = v.money(path+".rate", income["rate"], &currency, quantity.Money(budget.MinimumWage))
+		income["hours"] = v.number(path+".hours", income["hours"], 1)
+	case hasItems:
+		income["rate"] = v.money(path+".rate", income["rate"], &currency, 0.01)
+		income["items"] = v.integer(path+".items", income["items"], 1)
+	case hasVolume:
+		income["rate"] = v.percentage(path+".rate", income["rate"], 0)
+		items, ok := income["volume"].([]interface{})
+		if !ok {
+			v.fail(path+".volume", "not a list of monetary values")
+			break
+		}
+		volume := make([]interface{}, 0, len(items))
+		for index, item := range items {
+			volume = append(volume, v.money(fmt.Sprintf("%s.volume.%d", path, index), item, &currency, 0.01))
+		}
+		income["volume"] = volume
+	default:
+		if _, hasSalary := income["salary"]; hasSalary {
+			income["salary"] = v.money(path+".salary", income["salary"], &currency, 0.01)
+		} else if _, hasMoney := income["money"]; hasMoney {
+			income["money"] = v.money(path+".money", income["money"], &currency, 0.01)
+		} else {
+			v.fail(path, "unknown kind of income source; expected rate and hours, salary, rate and items, rate and volume, or money")
+		}
+	}
+
+	if currency != "" && (currency != v.currency || explicit) {
+		income["currency"] = currency
+	}
+}
+
+// expense validates and normalises the amount of an expense, which may be per another period than a month, as in "$300/quarter".
+// Expenses may be written as a plain amount.
+func (v *validator) expense(path string, value interface{}) interface{} {
+	expense, ok := value.(map[string]interface{})
+	if !ok {
+		expense = map[string]interface{}{"amount": value}
+	}
+	currency := v.currencyOf(path, expense)
+	explicit := currency != ""
+
+	switch amount := expense["amount"].(type) {
+	case float64:
+		if amount < 0.01 {
+			v.fail(path+".amount", "must be at least %s", quantity.Money(0.01).Format(currency.Or(v.currency)))
+		}
+	case string:
+		rate, err := quantity.NewRate(amount)
+		if err != nil {
+			v.fail(path+".amount", "not a monetary value, optionally per period, such as $25/week")
+			break
+		}
+		if !rate.Per.IsCalendar() {
+			v.fail(path+".amount", "per hour of work, which depends on the hours worked; give the amount per day, week or month instead")
+			break
+		}
+		if rate.Currency != "" {
+			if !explicit {
+				currency = rate.Currency
+			} else if rate.Currency != currency {
+				v.fail(path+".amount", "written in %s, but paid in %s", rate.Currency, currency)
+				break
+			}
+		}
+		if rate.Money < 0.01 {
+			v.fail(path+".amount", "must be at least %s", quantity.Money(0.01).Format(currency.Or(v.currency)))
+		}
+		expense["amount"] = rate.Monthly().ValueOf()
+	default:
+		v.fail(path+".amount", "not a monetary value, optionally per period, such as $25/week")
+	}
+
+	if currency != "" && (currency != v.currency || explicit) {
+		expense["currency"] = currency
+	}
+	return expense
+}
+
+// decodeBudget decodes, validates and normalises a named budget sent in a request
+func decodeBudget(name string, data []byte) (*budget.Budget, error) {
+	var request map[string]interface{}
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, validationError{"": "not a JSON object"}
+	}
+
+	v := &validator{err: make(validationError)}
+	v.currency = v.currencyOf("", request).Or(quantity.DefaultCurrency)
+	if savings, given := request["savings"]; given {
+		currency := v.currency
+		request["savings"] = v.money("savings", savings, &currency, 0)
+	}
+	if income, given := request["income"]; given {
+		list, ok := income.(map[string]interface{})
+		if !ok {
+			v.fail("income", "not an object of named income sources")
+		}
+		for incomeName, value := range list {
+			if object, ok := value.(map[string]interface{}); ok {
+				v.income("income."+incomeName, object)
+			} else {
+				v.fail("income."+incomeName, "not an object")
+			}
+		}
+	}
+	if expenses, given := request["expenses"]; given {
+		list, ok := expenses.(map[string]interface{})
+		if !ok {
+			v.fail("expenses", "not an object of named expenses")
+		}
+		for expenseName, value := range list {
+			list[expenseName] = v.expense("expenses."+expenseName, value)
+		}
+	}
+	if len(v.err) > 0 {
+		return nil, v.err
+	}
+
+	normalised, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	decoded := budget.Make(name)
+	if err := json.Unmarshal(normalised, decoded); err != nil {
+		return nil, validationError{"": err.Error()}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,234 @@
+package statements
+
+import (
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// statementLocale is the locale amounts are written in when no other is given, and the only one QIF amounts are written in
+var statementLocale = quantity.MakeLocale("en-US")
+
+var (
+	errMissingDateColumn   = errors.New("no date column was mapped")
+	errMissingAmountColumn = errors.New("no amount column, nor debit and credit columns, were mapped")
+	errMissingHeader       = errors.New("columns can only be mapped by name when the file has a header")
+)
+
+// CSVMapping describes how the columns of a bank-exported CSV file map to transactions.
+// Columns are given either by their name in the header, or by their position starting at 1.
+type CSVMapping struct {
+	Delimiter    rune             // Field delimiter, which defaults to a comma
+	Header       bool             // Whether the first row names the columns
+	DateColumn   string           // Column of the transaction date
+	DateFormat   string           // Format of the date, such as "YYYY-MM-DD", "MM/DD/YYYY" or "M/D/YYYY"
+	Locale       *quantity.Locale // Locale the amounts are written in, which defaults to en-US, as in "1,234.56"
+	AmountColumn string           // Column of the signed amount, which is ignored when debit or credit columns are given
+	DebitColumn  string           // Column of money spent, as a positive amount
+	CreditColumn string           // Column of money received, as a positive amount
+	PayeeColumn  string           // Column of the payee or description
+	MemoColumn   string           // Column of an optional memo
+}
+
+// dateLayout converts a human-friendly date format into a time layout. YYYY and YY are the year, MMM the abbreviated name of
+// the month, MM and DD the zero-padded month and day, and M and D the month and day without padding.
+func dateLayout(format string) string {
+	if format == "" {
+		return budget.DateLayout
+	}
+	return strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MMM", "Jan",
+		"MM", "01",
+		"DD", "02",
+		"M", "1",
+		"D", "2",
+	).Replace(format)
+}
+
+// columnIndex resolves a column given by name or by position into a zero-based index, or -1 if no column was given
+func columnIndex(column string, header []string) (int, error) {
+	if column == "" {
+		return -1, nil
+	}
+	if position, err := strconv.Atoi(column); err == nil {
+		if position < 1 {
+			return -1, fmt.Errorf("invalid column position %d", position)
+		}
+		return position - 1, nil
+	}
+	if header == nil {
+		return -1, errMissingHeader
+	}
+	for index, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return index, nil
+		}
+	}
+	return -1, fmt.Errorf("no column named %q", column)
+}
+
+// field returns the trimmed field at the given index, or an empty string if absent
+func field(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
+
+// ParseAmount parses a monetary amount as written in bank statements in the given locale, including amounts with currency symbols,
+// amounts with any number of decimal places and negative amounts written in parentheses. Amounts are read in en-US if no locale is given,
+// rather than in quantity.DefaultLocale, since statements are written as the bank formats them.
+func ParseAmount(value string, locale *quantity.Locale) (quantity.Money, error) {
+	if locale == nil {
+		locale = statementLocale
+	}
+	value = strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+		negative = true
+	}
+	if strings.HasSuffix(value, "-") {
+		value = strings.TrimSuffix(value, "-")
+		negative = !negative
+	}
+
+	money, _, err := locale.ParseMoney(value)
+	if err != nil {
+		number, numberErr := locale.ParseNumber(strings.Replace(value, "$", "", 1))
+		if numberErr != nil {
+			return 0, err
+		}
+		money = quantity.Money(number)
+	}
+
+	if negative {
+		money = -money
+	}
+	return money, nil
+}
+
+// fingerprint identifies a row by its contents and how many identical rows preceded it
+func fingerprint(prefix string, occurrences map[string]int, parts ...string) string {
+	hash := sha1.Sum([]byte(strings.Join(parts, "\x1f")))
+	key := hex.EncodeToString(hash[:])
+	occurrences[key]++
+	return fmt.Sprintf("%s:%s:%d", prefix, key, occurrences[key])
+}
+
+// ReadCSV reads transactions from a bank-exported CSV file using the given column mapping
+func ReadCSV(reader io.Reader, mapping CSVMapping) ([]*budget.Transaction, error) {
+	csvReader := csv.NewReader(reader)
+	if mapping.Delimiter != 0 {
+		csvReader.Comma = mapping.Delimiter
+	}
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var header []string
+	if mapping.Header && len(records) > 0 {
+		header, records = records[0], records[1:]
+	}
+
+	// Debit and credit columns take priority, so that they can be given without unmapping a default amount column
+	amountColumn := mapping.AmountColumn
+	if mapping.DebitColumn != "" || mapping.CreditColumn != "" {
+		amountColumn = ""
+	}
+
+	var dateIndex, amountIndex, debitIndex, creditIndex, payeeIndex, memoIndex int
+	for _, column := range []struct {
+		index  *int
+		column string
+	}{
+		{&dateIndex, mapping.DateColumn},
+		{&amountIndex, amountColumn},
+		{&debitIndex, mapping.DebitColumn},
+		{&creditIndex, mapping.CreditColumn},
+		{&payeeIndex, mapping.PayeeColumn},
+		{&memoIndex, mapping.MemoColumn},
+	} {
+		if *column.index, err = columnIndex(column.column, header); err != nil {
+			return nil, err
+		}
+	}
+	if dateIndex < 0 {
+		return nil, errMissingDateColumn
+	}
+	if amountIndex < 0 && debitIndex < 0 && creditIndex < 0 {
+		return nil, errMissingAmountColumn
+	}
+
+	layout := dateLayout(mapping.DateFormat)
+	occurrences := make(map[string]int)
+	transactions := make([]*budget.Transaction, 0, len(records))
+	for rowIndex, record := range records {
+		row := rowIndex + 1
+		if mapping.Header {
+			row++
+		}
+		if len(record) == 1 && field(record, 0) == "" {
+			continue
+		}
+
+		date, err := time.Parse(layout, field(record, dateIndex))
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid date %q", row, field(record, dateIndex))
+		}
+
+		var amount quantity.Money
+		if amountIndex >= 0 {
+			if amount, err = ParseAmount(field(record, amountIndex), mapping.Locale); err != nil {
+				return nil, fmt.Errorf("row %d: invalid amount %q", row, field(record, amountIndex))
+			}
+		} else {
+			if debit := field(record, debitIndex); debit != "" {
+				debitAmount, err := ParseAmount(debit, mapping.Locale)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: invalid debit %q", row, debit)
+				}
+				amount -= quantity.Money(math.Abs(debitAmount.ValueOf()))
+			}
+			if credit := field(record, creditIndex); credit != "" {
+				creditAmount, err := ParseAmount(credit, mapping.Locale)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: invalid credit %q", row, credit)
+				}
+				amount += quantity.Money(math.Abs(creditAmount.ValueOf()))
+			}
+		}
+
+		transaction := &budget.Transaction{
+			Date:   date,
+			Amount: amount,
+			Payee:  field(record, payeeIndex),
+			Memo:   field(record, memoIndex),
+		}
+		transaction.ImportID = fingerprint("csv", occurrences,
+			transaction.Date.Format(budget.DateLayout),
+			strconv.FormatFloat(transaction.Amount.ValueOf(), 'f', 2, 64),
+			transaction.Payee,
+			transaction.Memo,
+		)
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,165 @@
+package statements
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+func TestDateLayout(t *testing.T) {
+	for _, test := range []struct {
+		format string
+		value  string
+		want   time.Time
+	}{
+		{"", "2026-09-05", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"YYYY-MM-DD", "2026-09-05", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"MM/DD/YYYY", "09/05/2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"DD.MM.YY", "05.09.26", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"DD-MMM-YYYY", "05-Sep-2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"M/D/YYYY", "9/5/2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"M/D/YYYY", "12/25/2026", time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"D/M/YY", "5/9/26", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"D MMM YYYY", "5 Sep 2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+	} {
+		got, err := time.Parse(dateLayout(test.format), test.value)
+		if err != nil {
+			t.Errorf("%q with format %q: %s", test.value, test.format, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("%q with format %q: got %s, want %s", test.value, test.format, got, test.want)
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	statement := `Date,Description,Debit,Credit
+9/5/2026,Grocery Store,$45.10,
+9/15/2026,Employer,,"$1,250.00"
+9/15/2026,Employer,,"$1,250.00"
+
+10/1/2026,Landlord,"(1,200.00)",
+`
+	transactions, err := ReadCSV(strings.NewReader(statement), CSVMapping{
+		Header:       true,
+		DateColumn:   "Date",
+		DateFormat:   "M/D/YYYY",
+		DebitColumn:  "Debit",
+		CreditColumn: "4",
+		PayeeColumn:  "description",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 4 {
+		t.Fatalf("got %d transactions, want 4", len(transactions))
+	}
+
+	for index, want := range []struct {
+		date   time.Time
+		amount float64
+		payee  string
+	}{
+		{time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), -45.10, "Grocery Store"},
+		{time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC), 1250, "Employer"},
+		{time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC), 1250, "Employer"},
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), -1200, "Landlord"},
+	} {
+		got := transactions[index]
+		if !got.Date.Equal(want.date) || got.Amount.ValueOf() != want.amount || got.Payee != want.payee {
+			t.Errorf("transaction %d: got %s %v %q, want %s %v %q", index+1, got.Date, got.Amount, got.Payee, want.date, want.amount, want.payee)
+		}
+	}
+	if transactions[1].ImportID == transactions[2].ImportID {
+		t.Error("identical rows have the same import identifier")
+	}
+
+	if _, err := ReadCSV(strings.NewReader("Date,Amount\n2026-09-05,10\n"), CSVMapping{Header: true, DateColumn: "Date", DateFormat: "M/D/YYYY", AmountColumn: "Amount"}); err == nil {
+		t.Error("a date not matching the format was read")
+	}
+	if _, err := ReadCSV(strings.NewReader("9/5/2026,10\n"), CSVMapping{DateColumn: "Date", AmountColumn: "2"}); err == nil {
+		t.Error("a column was mapped by name without a header")
+	}
+}
+
+func TestReadCSVDebitCreditWithDefaultAmountColumn(t *testing.T) {
+	statement := `Date,Description,Debit,Credit
+2026-09-05,Grocery Store,45.10,
+2026-09-15,Employer,,1250.00
+`
+	// As mapped by the default flags of the import command, with only the debit and credit columns given
+	transactions, err := ReadCSV(strings.NewReader(statement), CSVMapping{
+		Header:       true,
+		DateColumn:   "Date",
+		DateFormat:   "YYYY-MM-DD",
+		AmountColumn: "Amount",
+		DebitColumn:  "Debit",
+		CreditColumn: "Credit",
+		PayeeColumn:  "Description",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("got %d transactions, want 2", len(transactions))
+	}
+	for index, want := range []float64{-45.10, 1250} {
+		if got := transactions[index].Amount.ValueOf(); got != want {
+			t.Errorf("transaction %d: got %v, want %v", index+1, got, want)
+		}
+	}
+}
+
+func TestParseAmountLocale(t *testing.T) {
+	// Statements are read in the locale they are written in, whichever locale quantities are displayed in
+	defer func(locale *quantity.Locale) { quantity.DefaultLocale = locale }(quantity.DefaultLocale)
+	quantity.DefaultLocale = quantity.MakeLocale("de-DE")
+
+	for _, test := range []struct {
+		value  string
+		locale *quantity.Locale
+		want   float64
+	}{
+		{"-45.10", nil, -45.10},
+		{"$1,250.00", nil, 1250},
+		{"(1,200.00)", nil, -1200},
+		{"12.345", nil, 12.345},
+		{"-45,10", quantity.MakeLocale("de-DE"), -45.10},
+		{"1.250,00 €", quantity.MakeLocale("de-DE"), 1250},
+		{"1.250", quantity.MakeLocale("de-DE"), 1250},
+	} {
+		got, err := ParseAmount(test.value, test.locale)
+		if err != nil {
+			t.Errorf("%q: %s", test.value, err)
+		} else if got.ValueOf() != test.want {
+			t.Errorf("%q: got %v, want %v", test.value, got, test.want)
+		}
+	}
+
+	transactions, err := ReadQIF(strings.NewReader("!Type:Bank\nD9/5/2026\nT-45.10\n^\nD9/6/2026\nT1,250.00\n^\n"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index, want := range []float64{-45.10, 1250} {
+		if got := transactions[index].Amount.ValueOf(); got != want {
+			t.Errorf("QIF transaction %d: got %v, want %v", index+1, got, want)
+		}
+	}
+
+	transactions, err = ReadCSV(strings.NewReader("Date;Amount\n2026-09-05;-1.250,50\n"), CSVMapping{
+		Delimiter:    ';',
+		Header:       true,
+		DateColumn:   "Date",
+		Locale:       quantity.MakeLocale("de-DE"),
+		AmountColumn: "Amount",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transactions[0].Amount.ValueOf(); got != -1250.50 {
+		t.Errorf("CSV in de-DE: got %v, want -1250.50", got)
+	}
+}
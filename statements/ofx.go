@@ -0,0 +1,115 @@
+package statements
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+var (
+	errNotOFX = errors.New("not an OFX statement")
+
+	ofxTransactionRegexp = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	ofxAccountRegexp     = regexp.MustCompile(`(?is)<(?:BANKACCTFROM|CCACCTFROM)>.*?<ACCTID>\s*([^<\r\n]*)`)
+	ofxElementRegexp     = regexp.MustCompile(`<([A-Za-z0-9.]+)>\s*([^<\r\n]*)`)
+	ofxDateRegexp        = regexp.MustCompile(`^(\d{8})`)
+)
+
+// ofxElements returns the values of the elements within an aggregate by name. This handles both the SGML
+// variant, where elements holding values are not closed, and the XML variant, where they are.
+func ofxElements(aggregate string) map[string]string {
+	elements := make(map[string]string)
+	for _, match := range ofxElementRegexp.FindAllStringSubmatch(aggregate, -1) {
+		name := strings.ToUpper(match[1])
+		if _, exists := elements[name]; !exists {
+			elements[name] = html.UnescapeString(strings.TrimSpace(match[2]))
+		}
+	}
+	return elements
+}
+
+// parseOFXDate parses an OFX date, such as 20260915, 20260915120000 or 20260915120000.000[-5:EST].
+// Only the calendar date is kept.
+func parseOFXDate(value string) (time.Time, error) {
+	match := ofxDateRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse("20060102", match[1])
+}
+
+// parseOFXAmount parses an OFX amount, which is written with a period or comma as the decimal separator
+// regardless of locale, such as -12.50 or -12,50
+func parseOFXAmount(value string) (quantity.Money, error) {
+	amount, err := strconv.ParseFloat(strings.Replace(strings.TrimSpace(value), ",", ".", 1), 64)
+	if err != nil {
+		return 0, err
+	}
+	return quantity.Money(amount), nil
+}
+
+// ReadOFX reads transactions from an OFX or QFX statement, in either the SGML (1.x) or XML (2.x) variant.
+// Each transaction is identified by its FITID, so statements that overlap are only imported once.
+func ReadOFX(reader io.Reader) ([]*budget.Transaction, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	document := string(data)
+	start := strings.Index(strings.ToUpper(document), "<OFX>")
+	if start < 0 {
+		return nil, errNotOFX
+	}
+	document = document[start:]
+
+	var account string
+	if match := ofxAccountRegexp.FindStringSubmatch(document); match != nil {
+		account = strings.TrimSpace(match[1])
+	}
+
+	matches := ofxTransactionRegexp.FindAllStringSubmatch(document, -1)
+	transactions := make([]*budget.Transaction, 0, len(matches))
+	for index, match := range matches {
+		elements := ofxElements(match[1])
+
+		date, err := parseOFXDate(elements["DTPOSTED"])
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", index+1, err)
+		}
+
+		amount, err := parseOFXAmount(elements["TRNAMT"])
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: invalid amount %q", index+1, elements["TRNAMT"])
+		}
+
+		payee := elements["NAME"]
+		if payee == "" {
+			payee = elements["PAYEE"]
+		}
+
+		transaction := &budget.Transaction{
+			Date:   date,
+			Amount: amount,
+			Payee:  payee,
+			Memo:   elements["MEMO"],
+		}
+		if fitID := elements["FITID"]; fitID != "" {
+			transaction.ImportID = fmt.Sprintf("ofx:%s:%s", account, fitID)
+		} else {
+			return nil, fmt.Errorf("transaction %d: missing FITID", index+1)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,66 @@
+package statements
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadOFX(t *testing.T) {
+	for _, test := range []struct {
+		variant   string
+		statement string
+	}{
+		{"SGML", `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKACCTFROM><BANKID>123<ACCTID>98765<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20260905120000.000[-5:EST]<TRNAMT>-45,10<FITID>A1<NAME>Grocery &amp; Deli<MEMO>Card purchase</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20260915<TRNAMT>1250.00<FITID>A2<PAYEE>Employer</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`},
+		{"XML", `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+<CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+<CCACCTFROM><ACCTID>98765</ACCTID></CCACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20260905</DTPOSTED><TRNAMT>-45.10</TRNAMT><FITID>A1</FITID><NAME>Grocery &amp; Deli</NAME><MEMO>Card purchase</MEMO></STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20260915</DTPOSTED><TRNAMT>1250.00</TRNAMT><FITID>A2</FITID><NAME>Employer</NAME></STMTTRN>
+</BANKTRANLIST>
+</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>`},
+	} {
+		transactions, err := ReadOFX(strings.NewReader(test.statement))
+		if err != nil {
+			t.Errorf("%s: %s", test.variant, err)
+			continue
+		}
+		if len(transactions) != 2 {
+			t.Errorf("%s: got %d transactions, want 2", test.variant, len(transactions))
+			continue
+		}
+
+		first, second := transactions[0], transactions[1]
+		if !first.Date.Equal(time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)) || first.Amount != -45.10 || first.Payee != "Grocery & Deli" || first.Memo != "Card purchase" {
+			t.Errorf("%s: got first transaction %+v", test.variant, first)
+		}
+		if first.ImportID != "ofx:98765:A1" {
+			t.Errorf("%s: got import identifier %q, want %q", test.variant, first.ImportID, "ofx:98765:A1")
+		}
+		if !second.Date.Equal(time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC)) || second.Amount != 1250 || second.Payee != "Employer" {
+			t.Errorf("%s: got second transaction %+v", test.variant, second)
+		}
+	}
+
+	if _, err := ReadOFX(strings.NewReader("Date,Amount\n")); err != errNotOFX {
+		t.Errorf("got error %v for a statement that is not OFX, want %v", err, errNotOFX)
+	}
+	if _, err := ReadOFX(strings.NewReader("<OFX><STMTTRN><DTPOSTED>20260905<TRNAMT>-1.00</STMTTRN></OFX>")); err == nil {
+		t.Error("a transaction without a FITID was read")
+	}
+}
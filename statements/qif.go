@@ -0,0 +1,129 @@
+package statements
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorucoder/budgetbuddy/budget"
+)
+
+var qifDateSeparatorRegexp = regexp.MustCompile(`[/.'-]`)
+
+// parseQIFDate parses a QIF date whose day, month and year are ordered as in the given format, such as "MM/DD/YYYY".
+// Quicken omits leading zeros and writes years after 1999 with an apostrophe, as in 9/15'26, so both are accepted.
+func parseQIFDate(value string, format string) (time.Time, error) {
+	parts := qifDateSeparatorRegexp.Split(strings.TrimSpace(value), -1)
+	order := qifDateSeparatorRegexp.Split(strings.ToUpper(format), -1)
+	if len(parts) != 3 || len(order) != 3 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	var year, month, day int
+	for index, part := range parts {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", value)
+		}
+		switch order[index][0] {
+		case 'Y':
+			year = number
+			if year < 100 {
+				year += 2000
+			}
+		case 'M':
+			month = number
+		case 'D':
+			day = number
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return date, nil
+}
+
+// ReadQIF reads transactions from a QIF statement. Dates are read with the given format, such as "MM/DD/YYYY" or "DD/MM/YYYY".
+// Amounts are always written as in en-US, such as "-1,234.56". QIF has no transaction identifiers, so transactions are identified by
+// their contents.
+func ReadQIF(reader io.Reader, dateFormat string) ([]*budget.Transaction, error) {
+	if dateFormat == "" {
+		dateFormat = "MM/DD/YYYY"
+	}
+
+	scanner := bufio.NewScanner(reader)
+	occurrences := make(map[string]int)
+	transactions := make([]*budget.Transaction, 0)
+
+	var current *budget.Transaction
+	var line, recordLine int
+	var skipping bool
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+		if code == '!' {
+			// Only transaction lists of cash, bank and card accounts are read
+			header := strings.ToLower(value)
+			skipping = !(strings.HasPrefix(header, "type:bank") ||
+				strings.HasPrefix(header, "type:cash") ||
+				strings.HasPrefix(header, "type:ccard") ||
+				strings.HasPrefix(header, "type:oth"))
+			continue
+		}
+		if skipping {
+			continue
+		}
+
+		if current == nil {
+			current = &budget.Transaction{}
+			recordLine = line
+		}
+
+		switch code {
+		case 'D':
+			date, err := parseQIFDate(value, dateFormat)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			current.Date = date
+		case 'T', 'U':
+			amount, err := ParseAmount(value, statementLocale)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid amount %q", line, value)
+			}
+			current.Amount = amount
+		case 'P':
+			current.Payee = value
+		case 'M':
+			current.Memo = value
+		case '^':
+			if current.Date.IsZero() {
+				return nil, fmt.Errorf("line %d: transaction is missing a date", recordLine)
+			}
+			current.ImportID = fingerprint("qif", occurrences,
+				current.Date.Format(budget.DateLayout),
+				strconv.FormatFloat(current.Amount.ValueOf(), 'f', 2, 64),
+				current.Payee,
+				current.Memo,
+			)
+			transactions = append(transactions, current)
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
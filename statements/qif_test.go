@@ -0,0 +1,88 @@
+package statements
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseQIFDate(t *testing.T) {
+	for _, test := range []struct {
+		format string
+		value  string
+		want   time.Time
+	}{
+		{"MM/DD/YYYY", "09/05/2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"MM/DD/YYYY", "9/5'26", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"DD/MM/YYYY", "5/9/2026", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"YYYY-MM-DD", "2026-09-05", time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+	} {
+		got, err := parseQIFDate(test.value, test.format)
+		if err != nil {
+			t.Errorf("%q with format %q: %s", test.value, test.format, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("%q with format %q: got %s, want %s", test.value, test.format, got, test.want)
+		}
+	}
+
+	for _, value := range []string{"2/30/2026", "9/5", "Sept 5 2026"} {
+		if _, err := parseQIFDate(value, "MM/DD/YYYY"); err == nil {
+			t.Errorf("invalid date %q was parsed", value)
+		}
+	}
+}
+
+func TestReadQIF(t *testing.T) {
+	statement := `!Type:Bank
+D9/5'26
+T-45.10
+PGrocery Store
+MWeekly shop
+^
+D9/15'26
+U1,250.00
+PEmployer
+^
+!Type:Invst
+D9/20'26
+T-500.00
+PBroker
+^
+!Type:CCard
+D10/1/2026
+T-1,200.00
+PLandlord
+^
+`
+	transactions, err := ReadQIF(strings.NewReader(statement), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 3 {
+		t.Fatalf("got %d transactions, want 3 without the investment account", len(transactions))
+	}
+
+	for index, want := range []struct {
+		date   time.Time
+		amount float64
+		payee  string
+	}{
+		{time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), -45.10, "Grocery Store"},
+		{time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC), 1250, "Employer"},
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), -1200, "Landlord"},
+	} {
+		got := transactions[index]
+		if !got.Date.Equal(want.date) || got.Amount.ValueOf() != want.amount || got.Payee != want.payee {
+			t.Errorf("transaction %d: got %s %v %q, want %s %v %q", index+1, got.Date, got.Amount, got.Payee, want.date, want.amount, want.payee)
+		}
+	}
+	if transactions[0].Memo != "Weekly shop" {
+		t.Errorf("got memo %q, want %q", transactions[0].Memo, "Weekly shop")
+	}
+
+	if _, err := ReadQIF(strings.NewReader("!Type:Bank\nT-1.00\n^\n"), ""); err == nil {
+		t.Error("a transaction without a date was read")
+	}
+}
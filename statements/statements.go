@@ -0,0 +1,34 @@
+package statements
+
+import (
+	"github.com/sorucoder/budgetbuddy/budget"
+)
+
+// Result describes the outcome of importing a statement into a journal
+type Result struct {
+	Imported   int                   // Number of transactions recorded
+	Duplicates int                   // Number of transactions skipped because they were already imported
+	Unmatched  []*budget.Transaction // Recorded transactions that no rule linked to an expense or income source
+}
+
+// Import records the given statement transactions in a journal, skipping those already imported and
+// linking the rest to an expense or income source with the given rules
+func Import(journal *budget.Journal, rules budget.RuleList, transactions []*budget.Transaction) Result {
+	var result Result
+	for _, transaction := range transactions {
+		if transaction.ImportID != "" && journal.HasImported(transaction.ImportID) {
+			result.Duplicates++
+			continue
+		}
+
+		if category, matched := rules.Categorize(transaction); matched {
+			transaction.Category = category
+		} else {
+			result.Unmatched = append(result.Unmatched, transaction)
+		}
+
+		journal.Add(transaction)
+		result.Imported++
+	}
+	return result
+}
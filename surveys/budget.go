@@ -1,17 +1,52 @@
 package surveys
 
-import "github.com/sorucoder/budgetbuddy/budget"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
 
 func AskBudgetSurvey(budget *budget.Budget) error {
+	// Ask for currency
+	if err := askCurrencySurvey("Currency Of Budget:", &budget.Currency, quantity.DefaultCurrency); err != nil {
+		return err
+	}
+
 	// Ask for income
-	if err := askIncomeListSurvey(budget.Income); err != nil {
+	if err := askIncomeListSurvey(budget.Income, budget.ReportingCurrency()); err != nil {
 		return err
 	}
 
 	// Ask for expenses
-	if err := askExpenseListSurvey(budget.Expenses); err != nil {
+	if err := askExpenseListSurvey(budget.Expenses, budget.ReportingCurrency()); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// askCurrencySurvey asks the user to select a currency
+func askCurrencySurvey(message string, currency *quantity.Currency, defaultCurrency quantity.Currency) error {
+	var currencyAnswer string
+	if err := survey.AskOne(
+		&survey.Select{
+			Message: message,
+			Options: quantity.Currencies(),
+			Default: defaultCurrency.String(),
+		},
+		&currencyAnswer,
+	); err != nil {
+		return err
+	}
+	*currency = quantity.Currency(currencyAnswer)
+	return nil
+}
+
+// currencyHint returns a faint hint of the symbol of the given currency, for use in prompts
+func currencyHint(currency quantity.Currency) termenv.Style {
+	return termenv.String(fmt.Sprintf("(%s)", strings.TrimSpace(currency.Symbol()))).Faint()
+}
@@ -2,7 +2,6 @@ package surveys
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/muesli/termenv"
@@ -10,14 +9,19 @@ import (
 	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-func askExpenseListSurvey(list budget.ExpenseList) error {
+const (
+	otherBucket = "(Other)"
+	untagged    = "(Untagged)"
+)
+
+func askExpenseListSurvey(list budget.ExpenseList, currency quantity.Currency) error {
 	fmt.Println(termenv.String("Expenses").Underline())
 	var done bool
 	for !done {
 		expenseTitle := fmt.Sprintf("%s Expense", quantity.MakeInteger(len(list)+1).Ordinal())
 		fmt.Println(termenv.String(expenseTitle).Italic())
 
-		if name, expense, err := askExpenseSurvey(); err == nil {
+		if name, expense, err := askExpenseSurvey(currency); err == nil {
 			list[name] = expense
 		} else {
 			return err
@@ -39,10 +43,13 @@ func askExpenseListSurvey(list budget.ExpenseList) error {
 	return nil
 }
 
-func askExpenseSurvey() (string, quantity.Money, error) {
+// askExpenseSurvey asks the user for an expense. The cost may be written in another currency than the budget, as in "€15.00", and
+// per another period than a month, as in "$1,200/quarter".
+func askExpenseSurvey(currency quantity.Currency) (string, *budget.Expense, error) {
 	var expense struct {
-		Name   string         `survey:"name"`
-		Amount quantity.Money `survey:"amount"`
+		Name   string        `survey:"name"`
+		Amount quantity.Rate `survey:"amount"`
+		Bucket string        `survey:"bucket"`
 	}
 	if err := survey.Ask(
 		[]*survey.Question{
@@ -55,20 +62,69 @@ func askExpenseSurvey() (string, quantity.Money, error) {
 			},
 			{
 				Name: "amount",
-				Prompt: &survey.Input{
-					Message: fmt.Sprintf("Cost of Expense %s:", termenv.String("($)").Faint()),
-				},
+				Prompt: rateInput(&survey.Input{
+					Message: fmt.Sprintf("Cost of Expense %s:", currencyHint(currency)),
+					Help:    "The cost per month, or per another period, such as $1,200/quarter or $25 weekly.",
+				}, currency),
 				Validate: survey.ComposeValidators(
 					survey.Required,
-					moneyValidator,
-					boundedMoneyValidator(0.01, nil),
+					rateValidator,
+					boundedRateValidator(0.01, nil),
 				),
 			},
+			{
+				Name: "bucket",
+				Prompt: &survey.Select{
+					Message: "Bucket of Expense:",
+					Options: []string{budget.NeedsBucket, budget.WantsBucket, budget.SavingsBucket, otherBucket, untagged},
+					Help:    "The bucket the expense is graded in by allocation rules, such as 50/30/20.",
+				},
+			},
 		},
 		&expense,
 	); err != nil {
-		return "", quantity.Money(math.NaN()), err
+		return "", nil, err
 	}
 
-	return expense.Name, expense.Amount, nil
+	switch expense.Bucket {
+	case untagged:
+		expense.Bucket = ""
+	case otherBucket:
+		if err := survey.AskOne(
+			&survey.Input{
+				Message: "Name of Bucket:",
+			},
+			&expense.Bucket,
+			survey.WithValidator(survey.Required),
+		); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var dueAnswer string
+	if err := survey.AskOne(
+		&survey.Input{
+			Message: fmt.Sprintf("Day Due %s:", termenv.String("(1-31, blank if unknown)").Faint()),
+			Help:    "The day of the month the expense is due, so calendars show when it is paid.",
+		},
+		&dueAnswer,
+		survey.WithValidator(optionalValidator(survey.ComposeValidators(integerValidator, boundedIntegerValidator(1, 31)))),
+	); err != nil {
+		return "", nil, err
+	}
+	var due int
+	if dueAnswer != "" {
+		due = int(quantity.MakeInteger(dueAnswer))
+	}
+
+	amountCurrency := expense.Amount.Currency
+	if amountCurrency == currency {
+		amountCurrency = ""
+	}
+	return expense.Name, &budget.Expense{
+		Amount:   expense.Amount.Monthly(),
+		Currency: amountCurrency,
+		Bucket:   budget.NormalizeBucket(expense.Bucket),
+		Due:      due,
+	}, nil
 }
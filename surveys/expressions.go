@@ -0,0 +1,63 @@
+package surveys
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// expressionInput describes a survey.Input for a quantity that may be answered with an arithmetic expression, such as "45.99 + 12.50".
+// The result of the expression is echoed back beneath the answer, so the user can check it before it is saved.
+type expressionInput struct {
+	*survey.Input
+	write func(answer string) (string, error) // Evaluates and writes the answer
+}
+
+// Cleanup implements survey.Prompt for expressionInput
+func (input *expressionInput) Cleanup(config *survey.PromptConfig, answer interface{}) error {
+	if err := input.Input.Cleanup(config, answer); err != nil {
+		return err
+	}
+	if answerString, ok := answer.(string); ok && quantity.IsExpression(answerString) {
+		if result, err := input.write(answerString); err == nil {
+			fmt.Println(termenv.String(fmt.Sprintf("  = %s", result)).Faint())
+		}
+	}
+	return nil
+}
+
+// integerInput wraps the given survey.Input to answer a quantity.Integer with an arithmetic expression
+func integerInput(input *survey.Input) survey.Prompt {
+	return &expressionInput{input, func(answer string) (string, error) {
+		integer, err := quantity.NewInteger(answer)
+		return integer.String(), err
+	}}
+}
+
+// numberInput wraps the given survey.Input to answer a quantity.Number with an arithmetic expression
+func numberInput(input *survey.Input) survey.Prompt {
+	return &expressionInput{input, func(answer string) (string, error) {
+		number, err := quantity.NewNumber(answer)
+		return number.String(), err
+	}}
+}
+
+// moneyInput wraps the given survey.Input to answer a quantity.Money with an arithmetic expression. The result is written in the given
+// currency, unless the expression was written in another.
+func moneyInput(input *survey.Input, currency quantity.Currency) survey.Prompt {
+	return &expressionInput{input, func(answer string) (string, error) {
+		money, moneyCurrency, err := quantity.ParseMoney(answer)
+		return money.Format(moneyCurrency.Or(currency)), err
+	}}
+}
+
+// rateInput wraps the given survey.Input to answer a quantity.Rate with an arithmetic expression. The result is written in the given
+// currency, unless the expression was written in another.
+func rateInput(input *survey.Input, currency quantity.Currency) survey.Prompt {
+	return &expressionInput{input, func(answer string) (string, error) {
+		rate, err := quantity.NewRate(answer)
+		return rate.Format(currency), err
+	}}
+}
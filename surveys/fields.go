@@ -0,0 +1,142 @@
+package surveys
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Field describes an amount of an income source or expense that can be edited on its own, outside of a survey, such as in the
+// interactive interface. Answers are validated as they are in surveys.
+type Field struct {
+	Label    string                    // Label of the field, such as "Hourly Rate"
+	Value    func() string             // Writes the current value of the field
+	Set      func(answer string) error // Validates an answer and sets the field to it
+	Currency quantity.Currency         // Currency of the field, if it is an amount of money
+}
+
+// makeField makes a field that is validated by the given validator and set by writing the answer into the given target
+func makeField(label string, currency quantity.Currency, value func() string, target core.Settable, validator survey.Validator) Field {
+	return Field{
+		Label:    label,
+		Value:    value,
+		Currency: currency,
+		Set: func(answer string) error {
+			if err := validator(answer); err != nil {
+				return err
+			}
+			return target.WriteAnswer("", answer)
+		},
+	}
+}
+
+// volumeAnswer describes the items of commissions, answered as a list separated by semicolons
+type volumeAnswer struct {
+	volume *[]quantity.Money
+}
+
+// WriteAnswer implements core.Settable for volumeAnswer
+func (answer volumeAnswer) WriteAnswer(field string, value interface{}) error {
+	var volume []quantity.Money
+	for _, item := range strings.Split(fmt.Sprint(value), ";") {
+		money, err := quantity.NewMoney(strings.TrimSpace(item))
+		if err != nil {
+			return err
+		}
+		volume = append(volume, money)
+	}
+	*answer.volume = volume
+	return nil
+}
+
+// volumeValidator returns a survey.Validator that validates a list of items of commissions in the given currency separated by semicolons
+func volumeValidator(currency quantity.Currency) survey.Validator {
+	validator := survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(0.01, nil))
+	return func(answer interface{}) error {
+		for _, item := range strings.Split(fmt.Sprint(answer), ";") {
+			if err := validator(strings.TrimSpace(item)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// IncomeFields returns the fields of an income source that can be edited on their own
+func IncomeFields(income budget.Income, budgetCurrency quantity.Currency) []Field {
+	currency := income.Attributes().Currency.Or(budgetCurrency)
+	switch income := income.(type) {
+	case *budget.Wages:
+		return []Field{
+			makeField("Hourly Rate", currency, func() string { return income.Rate.Format(currency) }, &income.Rate,
+				survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(budget.MinimumWage, nil))),
+			makeField("Average Hours Per Week", "", income.Hours.String, &income.Hours,
+				survey.ComposeValidators(survey.Required, numberValidator, boundedNumberValidator(1, nil))),
+		}
+	case *budget.Salary:
+		return []Field{
+			makeField("Salary", currency, func() string { return income.Salary.Format(currency) }, &income.Salary,
+				survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(0.01, nil))),
+		}
+	case *budget.Sales:
+		return []Field{
+			makeField("Selling Price", currency, func() string { return income.Rate.Format(currency) }, &income.Rate,
+				survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(0.01, nil))),
+			makeField("Average Number of Items Sold", "", income.Items.String, &income.Items,
+				survey.ComposeValidators(survey.Required, integerValidator, boundedIntegerValidator(1, nil))),
+		}
+	case *budget.Commissions:
+		return []Field{
+			makeField("Percentage", "", income.Rate.String, &income.Rate,
+				survey.ComposeValidators(survey.Required, percentageValidator, boundedPercentageValidator(0, nil))),
+			makeField("Items (separated by ;)", currency, func() string {
+				items := make([]string, 0, len(income.Volume))
+				for _, volume := range income.Volume {
+					items = append(items, volume.Format(currency))
+				}
+				return strings.Join(items, "; ")
+			}, volumeAnswer{&income.Volume}, volumeValidator(currency)),
+		}
+	case *budget.Supplemental:
+		return []Field{
+			makeField("Supplemental Income", currency, func() string { return income.Money.Format(currency) }, &income.Money,
+				survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(0.01, nil))),
+		}
+	default:
+		return nil
+	}
+}
+
+// ExpenseField returns the field of the cost of an expense, which may be written in another currency than the budget, as in
+// "€15.00", and per another period than a month, as in "$1,200/quarter"
+func ExpenseField(expense *budget.Expense, budgetCurrency quantity.Currency) Field {
+	validator := survey.ComposeValidators(survey.Required, rateValidator, boundedRateValidator(0.01, nil))
+	return Field{
+		Label:    "Cost",
+		Currency: expense.Currency.Or(budgetCurrency),
+		Value: func() string {
+			return expense.Amount.Format(expense.Currency.Or(budgetCurrency))
+		},
+		Set: func(answer string) error {
+			if err := validator(answer); err != nil {
+				return err
+			}
+			rate, err := quantity.NewRate(answer)
+			if err != nil {
+				return err
+			}
+			expense.Amount = rate.Monthly()
+			if rate.Currency != "" {
+				expense.Currency = rate.Currency
+				if expense.Currency == budgetCurrency {
+					expense.Currency = ""
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
-type incomeSurvey func() (budget.Income, error)
+type incomeSurvey func(currency quantity.Currency) (budget.Income, error)
 
 var incomeSurveys = map[string]incomeSurvey{
 	"Wages":        askWagesSurvey,
@@ -20,14 +20,25 @@ var incomeSurveys = map[string]incomeSurvey{
 	"Supplemental": askSupplementalSurvey,
 }
 
-func askIncomeListSurvey(list budget.IncomeList) error {
+// paySchedules are the options for how often an income source is paid, in order
+var paySchedules = []string{"Weekly", "Every Two Weeks", "Twice a Month", "Monthly", "Irregularly"}
+
+// paySchedulePeriods maps the options of paySchedules to periods; irregular pay has no period
+var paySchedulePeriods = map[string]string{
+	"Weekly":          "week",
+	"Every Two Weeks": "2 weeks",
+	"Twice a Month":   "semimonth",
+	"Monthly":         "month",
+}
+
+func askIncomeListSurvey(list budget.IncomeList, currency quantity.Currency) error {
 	fmt.Println(termenv.String("Income").Underline())
 	var done bool
 	for !done {
 		incomeTitle := fmt.Sprintf("%s Source Of Income", quantity.MakeInteger(len(list)+1).Ordinal())
 		fmt.Println(termenv.String(incomeTitle).Italic())
 
-		if name, income, err := askIncomeSurvey(); err == nil {
+		if name, income, err := askIncomeSurvey(currency); err == nil {
 			list[name] = income
 		} else {
 			return err
@@ -49,7 +60,7 @@ func askIncomeListSurvey(list budget.IncomeList) error {
 	return nil
 }
 
-func askIncomeSurvey() (string, budget.Income, error) {
+func askIncomeSurvey(budgetCurrency quantity.Currency) (string, budget.Income, error) {
 	incomeTypes := make([]string, 0, len(incomeSurveys))
 	for incomeType := range incomeSurveys {
 		incomeTypes = append(incomeTypes, incomeType)
@@ -78,36 +89,76 @@ func askIncomeSurvey() (string, budget.Income, error) {
 		return "", nil, err
 	}
 
+	var incomeCurrencyAnswer quantity.Currency
+	if err := askCurrencySurvey("Currency Of Income:", &incomeCurrencyAnswer, budgetCurrency); err != nil {
+		return "", nil, err
+	}
+
 	var incomeAnswer budget.Income
-	if income, err := incomeSurveys[incomeTypeAnswer](); err == nil {
+	if income, err := incomeSurveys[incomeTypeAnswer](incomeCurrencyAnswer); err == nil {
 		incomeAnswer = income
 	} else {
 		return "", nil, err
 	}
+	if incomeCurrencyAnswer != budgetCurrency {
+		incomeAnswer.Attributes().Currency = incomeCurrencyAnswer
+	}
+
+	var paidEveryAnswer string
+	if err := survey.AskOne(
+		&survey.Select{
+			Message: "How Often Is It Paid?",
+			Options: paySchedules,
+			Default: "Monthly",
+		},
+		&paidEveryAnswer,
+	); err != nil {
+		return "", nil, err
+	}
+	if period, regular := paySchedulePeriods[paidEveryAnswer]; regular {
+		paidEvery := quantity.MakePeriod(period)
+		incomeAnswer.Attributes().PaidEvery = &paidEvery
+
+		var paidOnAnswer string
+		if err := survey.AskOne(
+			&survey.Input{
+				Message: fmt.Sprintf("Date Of A Recent Paycheck %s:", termenv.String("(YYYY-MM-DD, blank if unknown)").Faint()),
+				Help:    "Later paychecks are projected from this date, so calendars show when income is paid.",
+			},
+			&paidOnAnswer,
+			survey.WithValidator(optionalValidator(dateValidator)),
+		); err != nil {
+			return "", nil, err
+		}
+		if paidOn, err := budget.ParseDate(paidOnAnswer); err == nil {
+			incomeAnswer.Attributes().PaidOn = &paidOn
+		}
+	}
 
 	return incomeNameAnswer, incomeAnswer, nil
 }
 
-func askWagesSurvey() (budget.Income, error) {
+func askWagesSurvey(currency quantity.Currency) (budget.Income, error) {
 	var wages budget.Wages
 	if err := survey.Ask(
 		[]*survey.Question{
 			{
 				Name: "rate",
-				Prompt: &survey.Input{
-					Message: fmt.Sprintf("Hourly Rate %s:", termenv.String("($)").Faint()),
-				},
+				Prompt: moneyInput(&survey.Input{
+					Message: fmt.Sprintf("Hourly Rate %s:", currencyHint(currency)),
+				}, currency),
 				Validate: survey.ComposeValidators(
 					survey.Required,
 					moneyValidator,
+					currencyValidator(currency),
 					boundedMoneyValidator(budget.MinimumWage, nil),
 				),
 			},
 			{
 				Name: "hours",
-				Prompt: &survey.Input{
+				Prompt: numberInput(&survey.Input{
 					Message: fmt.Sprintf("Average Hours Per Week %s:", termenv.String("(#)").Faint()),
-				},
+				}),
 				Validate: survey.ComposeValidators(
 					survey.Required,
 					numberValidator,
@@ -122,17 +173,18 @@ func askWagesSurvey() (budget.Income, error) {
 	return &wages, nil
 }
 
-func askSalarySurvey() (budget.Income, error) {
+func askSalarySurvey(currency quantity.Currency) (budget.Income, error) {
 	var salary budget.Salary
 	if err := survey.AskOne(
-		&survey.Input{
-			Message: fmt.Sprintf("Salary %s:", termenv.String("($)").Faint()),
-		},
+		moneyInput(&survey.Input{
+			Message: fmt.Sprintf("Salary %s:", currencyHint(currency)),
+		}, currency),
 		&salary.Salary,
 		survey.WithValidator(
 			survey.ComposeValidators(
 				survey.Required,
 				moneyValidator,
+				currencyValidator(currency),
 				boundedMoneyValidator(0.01, nil),
 			),
 		),
@@ -142,26 +194,27 @@ func askSalarySurvey() (budget.Income, error) {
 	return &salary, nil
 }
 
-func askSalesSurvey() (budget.Income, error) {
+func askSalesSurvey(currency quantity.Currency) (budget.Income, error) {
 	var sales budget.Sales
 	if err := survey.Ask(
 		[]*survey.Question{
 			{
 				Name: "rate",
-				Prompt: &survey.Input{
-					Message: fmt.Sprintf("Selling Price %s:", termenv.String("($)").Faint()),
-				},
+				Prompt: moneyInput(&survey.Input{
+					Message: fmt.Sprintf("Selling Price %s:", currencyHint(currency)),
+				}, currency),
 				Validate: survey.ComposeValidators(
 					survey.Required,
 					moneyValidator,
+					currencyValidator(currency),
 					boundedMoneyValidator(0.01, nil),
 				),
 			},
 			{
 				Name: "items",
-				Prompt: &survey.Input{
+				Prompt: integerInput(&survey.Input{
 					Message: fmt.Sprintf("Average Number of Items Sold %s:", termenv.String("(@)").Faint()),
-				},
+				}),
 				Validate: survey.ComposeValidators(
 					survey.Required,
 					integerValidator,
@@ -176,7 +229,7 @@ func askSalesSurvey() (budget.Income, error) {
 	return &sales, nil
 }
 
-func askCommissionsSurvey() (budget.Income, error) {
+func askCommissionsSurvey(currency quantity.Currency) (budget.Income, error) {
 	var commissions budget.Commissions
 
 	if err := survey.AskOne(
@@ -194,11 +247,11 @@ func askCommissionsSurvey() (budget.Income, error) {
 	for !done {
 		var commissionVolume quantity.Money
 		if err := survey.AskOne(
-			&survey.Input{
-				Message: fmt.Sprintf("    Item #%d %s:", len(commissions.Volume)+1, termenv.String("($)").Faint()),
-			},
+			moneyInput(&survey.Input{
+				Message: fmt.Sprintf("    Item #%d %s:", len(commissions.Volume)+1, currencyHint(currency)),
+			}, currency),
 			&commissionVolume,
-			survey.WithValidator(survey.ComposeValidators(survey.Required, moneyValidator, boundedMoneyValidator(0.01, nil))),
+			survey.WithValidator(survey.ComposeValidators(survey.Required, moneyValidator, currencyValidator(currency), boundedMoneyValidator(0.01, nil))),
 		); err != nil {
 			return nil, err
 		}
@@ -218,17 +271,18 @@ func askCommissionsSurvey() (budget.Income, error) {
 	return &commissions, nil
 }
 
-func askSupplementalSurvey() (budget.Income, error) {
+func askSupplementalSurvey(currency quantity.Currency) (budget.Income, error) {
 	var supplemental budget.Supplemental
 	if err := survey.AskOne(
-		&survey.Input{
-			Message: fmt.Sprintf("Supplemental Income %s:", termenv.String("($)").Faint()),
-		},
+		moneyInput(&survey.Input{
+			Message: fmt.Sprintf("Supplemental Income %s:", currencyHint(currency)),
+		}, currency),
 		&supplemental.Money,
 		survey.WithValidator(
 			survey.ComposeValidators(
 				survey.Required,
 				moneyValidator,
+				currencyValidator(currency),
 				boundedMoneyValidator(0.01, nil),
 			),
 		),
@@ -0,0 +1,131 @@
+package surveys
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// Changes a scenario may make to a budget, in the order they are offered
+const (
+	scenarioIncome   = "Add or Change a Source of Income"
+	scenarioExpense  = "Add or Change an Expense"
+	scenarioRemove   = "Remove Income or Expenses"
+	scenarioNetPay   = "Assume Another Net Pay Percentage"
+	scenarioSavings  = "Assume Another Savings Balance"
+	scenarioFinished = "Done"
+)
+
+// AskScenarioSurvey asks the user for the changes a scenario makes to the given budget, until they are done. Income and expenses
+// given the name of ones in the budget replace them.
+func AskScenarioSurvey(baseBudget *budget.Budget, scenario *budget.Scenario) error {
+	currency := baseBudget.ReportingCurrency()
+
+	if err := survey.AskOne(
+		&survey.Input{
+			Message: fmt.Sprintf("Description %s:", termenv.String("(optional)").Faint()),
+			Default: scenario.Description,
+		},
+		&scenario.Description,
+	); err != nil {
+		return err
+	}
+
+	for {
+		var changeAnswer string
+		if err := survey.AskOne(
+			&survey.Select{
+				Message: "Change To Make:",
+				Options: []string{scenarioIncome, scenarioExpense, scenarioRemove, scenarioNetPay, scenarioSavings, scenarioFinished},
+			},
+			&changeAnswer,
+		); err != nil {
+			return err
+		}
+
+		switch changeAnswer {
+		case scenarioIncome:
+			name, income, err := askIncomeSurvey(currency)
+			if err != nil {
+				return err
+			}
+			scenario.Income[name] = income
+			scenario.Remove = withoutName(scenario.Remove, name)
+		case scenarioExpense:
+			name, expense, err := askExpenseSurvey(currency)
+			if err != nil {
+				return err
+			}
+			scenario.Expenses[name] = expense
+			scenario.Remove = withoutName(scenario.Remove, name)
+		case scenarioRemove:
+			names := append(baseBudget.Income.SortedNames(), baseBudget.Expenses.SortedNames()...)
+			if len(names) == 0 {
+				fmt.Println(termenv.String("The budget has no income or expenses to remove.").Faint())
+				continue
+			}
+			var removed []string
+			for _, name := range names {
+				if scenario.Removes(name) {
+					removed = append(removed, name)
+				}
+			}
+			if err := survey.AskOne(
+				&survey.MultiSelect{
+					Message: "Income and Expenses To Remove:",
+					Options: names,
+					Default: removed,
+				},
+				&scenario.Remove,
+			); err != nil {
+				return err
+			}
+			for _, name := range scenario.Remove {
+				delete(scenario.Income, name)
+				delete(scenario.Expenses, name)
+			}
+		case scenarioNetPay:
+			var netPayPercentage quantity.Percentage
+			if err := survey.AskOne(
+				&survey.Input{
+					Message: fmt.Sprintf("Net Pay Percentage %s:", termenv.String("(%)").Faint()),
+					Help:    "The share of gross pay received after taxes and deductions, such as 70%.",
+				},
+				&netPayPercentage,
+				survey.WithValidator(survey.ComposeValidators(survey.Required, percentageValidator, boundedPercentageValidator("1%", "100%"))),
+			); err != nil {
+				return err
+			}
+			scenario.NetPayPercentage = &netPayPercentage
+		case scenarioSavings:
+			var savings quantity.Money
+			if err := survey.AskOne(
+				moneyInput(&survey.Input{
+					Message: fmt.Sprintf("Savings Balance %s:", currencyHint(currency)),
+				}, currency),
+				&savings,
+				survey.WithValidator(survey.ComposeValidators(survey.Required, moneyValidator, boundedMoneyValidator(0, nil))),
+			); err != nil {
+				return err
+			}
+			scenario.Savings = &savings
+		default:
+			return nil
+		}
+		fmt.Println()
+	}
+}
+
+// withoutName returns the given names without the given one
+func withoutName(names []string, name string) []string {
+	kept := names[:0]
+	for _, other := range names {
+		if other != name {
+			kept = append(kept, other)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,95 @@
+package surveys
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+// AskTemplateSurvey asks the user only for the amounts left blank in a budget created from a template. Income sources the user does
+// not receive, and expenses they have none of, are removed.
+func AskTemplateSurvey(templateBudget *budget.Budget) error {
+	currency := templateBudget.ReportingCurrency()
+
+	var blankIncome []string
+	for _, name := range templateBudget.Income.SortedNames() {
+		if budget.IsBlank(templateBudget.Income[name]) {
+			blankIncome = append(blankIncome, name)
+		}
+	}
+	if len(blankIncome) > 0 {
+		fmt.Println(termenv.String("Income").Underline())
+	}
+	for _, name := range blankIncome {
+		income := templateBudget.Income[name]
+		fmt.Println(termenv.String(fmt.Sprintf("%s (%s)", name, budget.IncomeType(income))).Italic())
+
+		var included bool
+		if err := survey.AskOne(
+			&survey.Confirm{
+				Message: fmt.Sprintf("Do you receive income from %s?", name),
+				Default: true,
+			},
+			&included,
+		); err != nil {
+			return err
+		}
+		if !included {
+			delete(templateBudget.Income, name)
+			fmt.Println()
+			continue
+		}
+
+		incomeCurrency := income.Attributes().Currency.Or(currency)
+		filled, err := incomeSurveys[budget.IncomeType(income)](incomeCurrency)
+		if err != nil {
+			return err
+		}
+		*filled.Attributes() = *income.Attributes()
+		templateBudget.Income[name] = filled
+		fmt.Println()
+	}
+
+	var blankExpenses []string
+	for _, name := range templateBudget.Expenses.SortedNames() {
+		if templateBudget.Expenses[name].Amount == 0 {
+			blankExpenses = append(blankExpenses, name)
+		}
+	}
+	if len(blankExpenses) > 0 {
+		fmt.Println(termenv.String("Expenses").Underline())
+	}
+	for _, name := range blankExpenses {
+		expense := templateBudget.Expenses[name]
+		expenseCurrency := expense.Currency.Or(currency)
+
+		var amount quantity.Rate
+		if err := survey.AskOne(
+			rateInput(&survey.Input{
+				Message: fmt.Sprintf("Cost of %s %s:", name, currencyHint(expenseCurrency)),
+				Help:    "The cost per month, or per another period, such as $1,200/quarter or $25 weekly. Enter 0 if you have no such expense.",
+			}, expenseCurrency),
+			&amount,
+			survey.WithValidator(survey.ComposeValidators(survey.Required, rateValidator, boundedRateValidator(0, nil))),
+		); err != nil {
+			return err
+		}
+
+		if amount.Money == 0 {
+			delete(templateBudget.Expenses, name)
+			continue
+		}
+		expense.Amount = amount.Monthly()
+		if amount.Currency != "" {
+			expense.Currency = amount.Currency
+			if expense.Currency == currency {
+				expense.Currency = ""
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package surveys
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+)
+
+const uncategorized = "(Uncategorized)"
+
+// Directions of uncategorized transactions, which are neither an expense nor an income source to tell which way the money went
+const (
+	moneySpent    = "Money Spent"
+	moneyReceived = "Money Received"
+)
+
+// AskTransactionSurvey asks the user for a transaction against the given budget
+func AskTransactionSurvey(reportBudget *budget.Budget) (*budget.Transaction, error) {
+	categories := []string{uncategorized}
+	categories = append(categories, reportBudget.Expenses.SortedNames()...)
+	categories = append(categories, reportBudget.Income.SortedNames()...)
+
+	var answers struct {
+		Date     string         `survey:"date"`
+		Payee    string         `survey:"payee"`
+		Memo     string         `survey:"memo"`
+		Category string         `survey:"category"`
+		Amount   quantity.Money `survey:"amount"`
+	}
+	if err := survey.Ask(
+		[]*survey.Question{
+			{
+				Name: "date",
+				Prompt: &survey.Input{
+					Message: fmt.Sprintf("Date %s:", termenv.String("(YYYY-MM-DD)").Faint()),
+					Default: time.Now().Format(budget.DateLayout),
+				},
+				Validate: survey.ComposeValidators(survey.Required, dateValidator),
+			},
+			{
+				Name: "payee",
+				Prompt: &survey.Input{
+					Message: "Payee:",
+				},
+				Validate: survey.Required,
+			},
+			{
+				Name: "memo",
+				Prompt: &survey.Input{
+					Message: fmt.Sprintf("Memo %s:", termenv.String("(optional)").Faint()),
+				},
+			},
+			{
+				Name: "category",
+				Prompt: &survey.Select{
+					Message: "Expense or Income:",
+					Options: categories,
+				},
+			},
+			{
+				Name: "amount",
+				Prompt: moneyInput(&survey.Input{
+					Message: fmt.Sprintf("Amount %s:", currencyHint(reportBudget.ReportingCurrency())),
+				}, reportBudget.ReportingCurrency()),
+				Validate: survey.ComposeValidators(
+					survey.Required,
+					moneyValidator,
+					boundedMoneyValidator(0.01, nil),
+				),
+			},
+		},
+		&answers,
+	); err != nil {
+		return nil, err
+	}
+
+	date, _ := time.Parse(budget.DateLayout, answers.Date)
+	transaction := &budget.Transaction{
+		Date:   date,
+		Amount: answers.Amount,
+		Payee:  answers.Payee,
+		Memo:   answers.Memo,
+	}
+
+	// Money spent on expenses is negative, and money received from income sources positive. Uncategorized transactions,
+	// such as refunds or transfers, may go either way.
+	received := false
+	if answers.Category != uncategorized {
+		transaction.Category = answers.Category
+		_, received = reportBudget.Income[transaction.Category]
+	} else {
+		var direction string
+		if err := survey.AskOne(
+			&survey.Select{
+				Message: "Direction:",
+				Options: []string{moneySpent, moneyReceived},
+				Default: moneySpent,
+			},
+			&direction,
+		); err != nil {
+			return nil, err
+		}
+		received = direction == moneyReceived
+	}
+	if !received {
+		transaction.Amount = -transaction.Amount
+	}
+
+	return transaction, nil
+}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/sorucoder/budgetbuddy/budget"
 	"github.com/sorucoder/budgetbuddy/budget/quantity"
 )
 
@@ -21,6 +23,9 @@ var (
 	errNotNumber     = errors.New("Value must be a number.")
 	errNotMoney      = errors.New("Value must be a monetary value.")
 	errNotPercentage = errors.New("Value must be a percentage.")
+	errNotRate       = errors.New("Value must be a monetary value, optionally per period, such as $25/week.")
+	errHourlyRate    = errors.New("Value must be per day, week or longer, since the hours worked are unknown.")
+	errNotDate       = errors.New("Value must be a date formatted as YYYY-MM-DD.")
 )
 
 // integerValidator validates that a quantity.Integer was given
@@ -221,6 +226,19 @@ func boundedMoneyValidator(lowerBoundValue interface{}, upperBoundValue interfac
 	}
 }
 
+// currencyValidator returns a survey.Validator that validates that an amount of money, if written with a currency, was written in the given
+// currency, rather than dropping a currency that income is not paid in
+func currencyValidator(currency quantity.Currency) survey.Validator {
+	return func(answer interface{}) error {
+		if amount, err := quantity.NewAmount(answer); err == nil {
+			if _, err := amount.In(currency); err != nil {
+				return fmt.Errorf(`Value must be in %s, not %s.`, currency, amount.Currency)
+			}
+		}
+		return nil
+	}
+}
+
 // percentageValidator validates that a quantity.Percentage was given
 func percentageValidator(answer interface{}) error {
 	if _, err := quantity.NewPercentage(answer); err != nil {
@@ -287,3 +305,45 @@ func boundedPercentageValidator(lowerBoundValue interface{}, upperBoundValue int
 		return nil
 	}
 }
+
+// rateValidator validates that a quantity.Rate per calendar period was given
+func rateValidator(answer interface{}) error {
+	if rate, err := quantity.NewRate(answer); err != nil {
+		return errNotRate
+	} else if !rate.Per.IsCalendar() {
+		return errHourlyRate
+	}
+	return nil
+}
+
+// boundedRateValidator returns a survey.Validator that validates that a quantity.Rate, such that its amount per period is within the given
+// bounds inclusively, was given. The bounds are handled as in boundedMoneyValidator.
+func boundedRateValidator(lowerBoundValue interface{}, upperBoundValue interface{}) survey.Validator {
+	validateMoney := boundedMoneyValidator(lowerBoundValue, upperBoundValue)
+	return func(answer interface{}) error {
+		if rate, err := quantity.NewRate(answer); err == nil {
+			return validateMoney(rate.Money)
+		}
+		return errNotRate
+	}
+}
+
+// dateValidator validates that a date formatted as budget.DateLayout was given
+func dateValidator(answer interface{}) error {
+	if date, ok := answer.(string); !ok {
+		return errNotDate
+	} else if _, err := time.Parse(budget.DateLayout, date); err != nil {
+		return errNotDate
+	}
+	return nil
+}
+
+// optionalValidator returns a survey.Validator that validates an answer with the given validator, unless no answer was given
+func optionalValidator(validator survey.Validator) survey.Validator {
+	return func(answer interface{}) error {
+		if text, ok := answer.(string); ok && text == "" {
+			return nil
+		}
+		return validator(answer)
+	}
+}
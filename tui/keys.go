@@ -0,0 +1,95 @@
+package tui
+
+import "unicode/utf8"
+
+// keyCode identifies a key pressed in the interactive interface that is not a printable character
+type keyCode int
+
+const (
+	keyRune keyCode = iota // A printable character
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyHome
+	keyEnd
+	keyPageUp
+	keyPageDown
+	keyTab
+	keyBackTab
+	keyEnter
+	keyBackspace
+	keyEscape
+	keyInterrupt
+)
+
+// keyPress describes a single key pressed in the interactive interface
+type keyPress struct {
+	code keyCode
+	r    rune // Character typed, if code is keyRune
+}
+
+// escapeSequences maps the escape sequences sent by terminals for special keys, without the leading escape, to their keys
+var escapeSequences = map[string]keyCode{
+	"[A":  keyUp,
+	"[B":  keyDown,
+	"[C":  keyRight,
+	"[D":  keyLeft,
+	"OA":  keyUp,
+	"OB":  keyDown,
+	"OC":  keyRight,
+	"OD":  keyLeft,
+	"[H":  keyHome,
+	"[F":  keyEnd,
+	"OH":  keyHome,
+	"OF":  keyEnd,
+	"[1~": keyHome,
+	"[4~": keyEnd,
+	"[5~": keyPageUp,
+	"[6~": keyPageDown,
+	"[Z":  keyBackTab,
+}
+
+// decodeKeys decodes the keys pressed from input read from a terminal in raw mode. Unknown escape sequences are dropped.
+func decodeKeys(input []byte) []keyPress {
+	var keys []keyPress
+	for len(input) > 0 {
+		switch input[0] {
+		case 0x1b:
+			// Escape sequences end with a letter or a tilde; a lone escape is the escape key
+			end := 1
+			if len(input) > 1 && (input[1] == '[' || input[1] == 'O') {
+				for end = 2; end < len(input); end++ {
+					if (input[end] >= 'A' && input[end] <= 'Z') || (input[end] >= 'a' && input[end] <= 'z') || input[end] == '~' {
+						end++
+						break
+					}
+				}
+				if code, known := escapeSequences[string(input[1:end])]; known {
+					keys = append(keys, keyPress{code: code})
+				}
+			} else {
+				keys = append(keys, keyPress{code: keyEscape})
+			}
+			input = input[end:]
+			continue
+		case '\t':
+			keys = append(keys, keyPress{code: keyTab})
+		case '\r', '\n':
+			keys = append(keys, keyPress{code: keyEnter})
+		case 0x7f, 0x08:
+			keys = append(keys, keyPress{code: keyBackspace})
+		case 0x03:
+			keys = append(keys, keyPress{code: keyInterrupt})
+		default:
+			r, size := utf8.DecodeRune(input)
+			if r >= ' ' && r != utf8.RuneError {
+				keys = append(keys, keyPress{code: keyRune, r: r})
+			}
+			input = input[size:]
+			continue
+		}
+		input = input[1:]
+	}
+	return keys
+}
@@ -0,0 +1,76 @@
+// Package tui implements a full-screen interactive interface for browsing and editing budgets
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"golang.org/x/term"
+)
+
+// ErrNotTerminal is returned when the interactive interface is not run in a terminal
+var ErrNotTerminal = errors.New("the interactive interface must be run in a terminal")
+
+// Run runs the interactive interface on the named budget until the user quits. Amounts are edited in place and checked by the same
+// validators as the surveys, and the totals are recalculated after each change. The budget is only saved when the user asks to.
+func Run(name string, runBudget *budget.Budget) error {
+	input := int(os.Stdin.Fd())
+	output := int(os.Stdout.Fd())
+	if !term.IsTerminal(input) || !term.IsTerminal(output) {
+		return ErrNotTerminal
+	}
+
+	state, err := term.MakeRaw(input)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(input, state)
+
+	termenv.AltScreen()
+	termenv.HideCursor()
+	defer func() {
+		termenv.ShowCursor()
+		termenv.ExitAltScreen()
+	}()
+
+	v := newView(name, runBudget)
+	buffer := make([]byte, 64)
+	for !v.done {
+		v.width, v.height, err = term.GetSize(output)
+		if err != nil {
+			return err
+		}
+		draw(v.render())
+
+		count, err := os.Stdin.Read(buffer)
+		if err != nil {
+			return err
+		}
+		for _, key := range decodeKeys(buffer[:count]) {
+			v.handle(key)
+			if v.done {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// draw draws the lines of the interface over the whole screen. In raw mode, each line must return the cursor to its start.
+func draw(lines []string) {
+	var screen strings.Builder
+	screen.WriteString(termenv.CSI + "H")
+	for index, line := range lines {
+		if index > 0 {
+			screen.WriteString("\r\n")
+		}
+		screen.WriteString(line)
+		screen.WriteString(termenv.CSI + "K")
+	}
+	screen.WriteString(termenv.CSI + "J")
+	fmt.Print(screen.String())
+}
@@ -0,0 +1,390 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/muesli/termenv"
+	"github.com/sorucoder/budgetbuddy/budget"
+	"github.com/sorucoder/budgetbuddy/budget/quantity"
+	"github.com/sorucoder/budgetbuddy/surveys"
+)
+
+// pane identifies one of the panes of the interactive interface that can be browsed
+type pane int
+
+const (
+	incomePane pane = iota
+	expensePane
+	paneCount
+)
+
+// row describes a line of a pane, which is either the heading of an income source or a field that can be edited
+type row struct {
+	label  string
+	amount func() string  // Writes the amount shown to the right of the label, if any
+	field  *surveys.Field // Field edited by the row; if nil, the row is a heading
+}
+
+// view describes the state of the interactive interface
+type view struct {
+	name    string
+	budget  *budget.Budget
+	rows    [paneCount][]row
+	pane    pane
+	cursor  [paneCount]int // Index of the selected row of each pane
+	offset  [paneCount]int // Index of the first row of each pane shown
+	width   int
+	height  int
+	editing bool   // Whether the selected field is being edited
+	input   []rune // Answer being written into the selected field
+	message string // Message shown on the status line, such as an error
+	failed  bool   // Whether the message is an error
+	changed bool   // Whether the budget has unsaved changes
+	leaving bool   // Whether the user is being asked to save before quitting
+	done    bool   // Whether the interface should close
+}
+
+// newView makes a view of the named budget
+func newView(name string, viewBudget *budget.Budget) *view {
+	v := &view{name: name, budget: viewBudget}
+	currency := viewBudget.ReportingCurrency()
+
+	for _, incomeName := range viewBudget.Income.SortedNames() {
+		incomeName := incomeName
+		income := viewBudget.Income[incomeName]
+		v.rows[incomePane] = append(v.rows[incomePane], row{
+			label: incomeName,
+			amount: func() string {
+				return viewBudget.Income.Converted(incomeName, currency).Format(currency)
+			},
+		})
+		for _, field := range surveys.IncomeFields(income, currency) {
+			field := field
+			v.rows[incomePane] = append(v.rows[incomePane], row{label: "  " + field.Label, amount: field.Value, field: &field})
+		}
+	}
+
+	for _, expenseName := range viewBudget.Expenses.SortedNames() {
+		field := surveys.ExpenseField(viewBudget.Expenses[expenseName], currency)
+		v.rows[expensePane] = append(v.rows[expensePane], row{label: expenseName, amount: field.Value, field: &field})
+	}
+
+	for p := range v.rows {
+		v.cursor[p] = v.nextField(pane(p), -1, 1)
+	}
+	if v.cursor[incomePane] < 0 && v.cursor[expensePane] >= 0 {
+		v.pane = expensePane
+	}
+	return v
+}
+
+// nextField returns the index of the next field of a pane from the given row in the given direction, or -1 if there is none
+func (v *view) nextField(p pane, from int, direction int) int {
+	for index := from + direction; index >= 0 && index < len(v.rows[p]); index += direction {
+		if v.rows[p][index].field != nil {
+			return index
+		}
+	}
+	return -1
+}
+
+// selected returns the selected field, if any
+func (v *view) selected() *surveys.Field {
+	if v.cursor[v.pane] < 0 {
+		return nil
+	}
+	return v.rows[v.pane][v.cursor[v.pane]].field
+}
+
+// move moves the cursor of the current pane by the given number of fields
+func (v *view) move(fields int) {
+	direction := 1
+	if fields < 0 {
+		direction, fields = -1, -fields
+	}
+	for ; fields > 0; fields-- {
+		next := v.nextField(v.pane, v.cursor[v.pane], direction)
+		if next < 0 {
+			break
+		}
+		v.cursor[v.pane] = next
+	}
+}
+
+// handle updates the view for a key pressed
+func (v *view) handle(key keyPress) {
+	switch {
+	case v.leaving:
+		v.handleLeaving(key)
+	case v.editing:
+		v.handleEditing(key)
+	default:
+		v.handleBrowsing(key)
+	}
+}
+
+// handleBrowsing handles a key pressed while browsing the panes
+func (v *view) handleBrowsing(key keyPress) {
+	v.message = ""
+	switch key.code {
+	case keyUp:
+		v.move(-1)
+	case keyDown:
+		v.move(1)
+	case keyPageUp:
+		v.move(-v.paneHeight())
+	case keyPageDown:
+		v.move(v.paneHeight())
+	case keyHome:
+		v.cursor[v.pane] = v.nextField(v.pane, -1, 1)
+	case keyEnd:
+		v.cursor[v.pane] = v.nextField(v.pane, len(v.rows[v.pane]), -1)
+	case keyTab, keyBackTab, keyLeft, keyRight:
+		v.pane = (v.pane + 1) % paneCount
+	case keyEnter:
+		v.edit()
+	case keyEscape, keyInterrupt:
+		v.quit()
+	case keyRune:
+		switch key.r {
+		case 'k':
+			v.move(-1)
+		case 'j':
+			v.move(1)
+		case 'h', 'l':
+			v.pane = (v.pane + 1) % paneCount
+		case 'e':
+			v.edit()
+		case 's':
+			v.save()
+		case 'q':
+			v.quit()
+		}
+	}
+}
+
+// edit starts editing the selected field with its current value
+func (v *view) edit() {
+	field := v.selected()
+	if field == nil {
+		return
+	}
+	v.editing = true
+	v.input = []rune(field.Value())
+	v.message, v.failed = "", false
+}
+
+// handleEditing handles a key pressed while editing a field
+func (v *view) handleEditing(key keyPress) {
+	switch key.code {
+	case keyRune:
+		v.input = append(v.input, key.r)
+	case keyBackspace:
+		if len(v.input) > 0 {
+			v.input = v.input[:len(v.input)-1]
+		}
+	case keyEnter:
+		field := v.selected()
+		if err := field.Set(strings.TrimSpace(string(v.input))); err != nil {
+			v.message, v.failed = err.Error(), true
+			return
+		}
+		v.editing, v.changed = false, true
+		v.message, v.failed = fmt.Sprintf("%s set to %s", strings.TrimSpace(v.rows[v.pane][v.cursor[v.pane]].label), field.Value()), false
+	case keyEscape, keyInterrupt:
+		v.editing = false
+		v.message = ""
+	}
+}
+
+// save saves the budget to disk
+func (v *view) save() {
+	if err := v.budget.Save(); err != nil {
+		v.message, v.failed = fmt.Sprintf(`Could not save budget "%s.budget": %s`, v.name, err), true
+		return
+	}
+	v.changed = false
+	v.message, v.failed = fmt.Sprintf(`Saved budget "%s.budget"`, v.name), false
+}
+
+// quit closes the interface, first asking whether to save if there are unsaved changes
+func (v *view) quit() {
+	if v.changed {
+		v.leaving = true
+		return
+	}
+	v.done = true
+}
+
+// handleLeaving handles a key pressed while being asked whether to save before quitting
+func (v *view) handleLeaving(key keyPress) {
+	switch {
+	case key.code == keyRune && (key.r == 'y' || key.r == 'Y'):
+		v.leaving = false
+		v.save()
+		v.done = !v.failed
+	case key.code == keyRune && (key.r == 'n' || key.r == 'N'):
+		v.done = true
+	case key.code == keyEscape || key.code == keyInterrupt || (key.code == keyRune && (key.r == 'c' || key.r == 'C')):
+		v.leaving = false
+	}
+}
+
+// summaryHeight is the number of lines taken by the summary pane
+const summaryHeight = 5
+
+// paneHeight returns the number of rows shown in the income and expense panes
+func (v *view) paneHeight() int {
+	// Leave room for the title, the headers and totals of the panes, the summary, the status line and the help line
+	height := v.height - 1 - 2 - summaryHeight - 2
+	if height < 1 {
+		height = 1
+	}
+	return height
+}
+
+// scroll scrolls each pane so that its selected row is shown
+func (v *view) scroll() {
+	height := v.paneHeight()
+	for p := range v.rows {
+		if v.cursor[p] < v.offset[p] {
+			v.offset[p] = v.cursor[p]
+			// Keep the heading of an income source in view with its first field
+			if v.offset[p] > 0 && v.rows[p][v.offset[p]-1].field == nil {
+				v.offset[p]--
+			}
+		} else if v.cursor[p] >= v.offset[p]+height {
+			v.offset[p] = v.cursor[p] - height + 1
+		}
+		if v.offset[p] < 0 {
+			v.offset[p] = 0
+		}
+	}
+}
+
+// line lays out a label and an amount across the given width, trimming the label if they do not fit
+func line(label string, amount string, width int) string {
+	space := width - text.RuneCount(amount) - 1
+	if space < 0 {
+		return text.Trim(amount, width)
+	}
+	return text.Pad(text.Trim(label, space), space, ' ') + " " + amount
+}
+
+// renderPane renders a pane as lines of the given width
+func (v *view) renderPane(p pane, title string, total quantity.Money, width int) []string {
+	currency := v.budget.ReportingCurrency()
+	height := v.paneHeight()
+
+	header := termenv.String(text.Pad(" "+title, width, ' ')).Bold()
+	if p == v.pane {
+		header = header.Reverse()
+	}
+	lines := []string{header.String()}
+
+	for index := v.offset[p]; index < v.offset[p]+height; index++ {
+		if index >= len(v.rows[p]) {
+			lines = append(lines, strings.Repeat(" ", width))
+			continue
+		}
+		row := v.rows[p][index]
+		content := line(" "+row.label, row.amount()+" ", width)
+		switch {
+		case row.field == nil:
+			lines = append(lines, termenv.String(content).Bold().String())
+		case p == v.pane && index == v.cursor[p] && v.editing:
+			lines = append(lines, termenv.String(content).Underline().String())
+		case p == v.pane && index == v.cursor[p]:
+			lines = append(lines, termenv.String(content).Reverse().String())
+		default:
+			lines = append(lines, content)
+		}
+	}
+
+	lines = append(lines, termenv.String(line(" Total", total.Format(currency)+" ", width)).Bold().String())
+	return lines
+}
+
+// renderSummary renders the summary pane as lines of the given width, recalculated from the budget as it is now
+func (v *view) renderSummary(width int) []string {
+	currency := v.budget.ReportingCurrency()
+	income := v.budget.Income.Sum(currency)
+	expenses := v.budget.Expenses.Sum(currency)
+	remaining := v.budget.Sum()
+
+	column := width / 3
+	header := text.Pad(" Summary", column, ' ') + text.Pad("Per Month", column, ' ') + "Per Year"
+	amounts := func(label string, money quantity.Money) string {
+		return text.Pad(" "+label, column, ' ') +
+			text.Pad(money.Format(currency), column, ' ') +
+			budget.PerPeriod(money, quantity.Year).Format(currency)
+	}
+
+	remainingLine := termenv.String(amounts("Remaining", remaining))
+	if remaining < 0 {
+		remainingLine = remainingLine.Foreground(termenv.ANSIRed)
+	} else {
+		remainingLine = remainingLine.Foreground(termenv.ANSIGreen)
+	}
+	return []string{
+		termenv.String(text.Pad(header, width, ' ')).Bold().Reverse().String(),
+		amounts("Income", income),
+		amounts("Expenses", expenses),
+		remainingLine.String(),
+		"",
+	}
+}
+
+// render renders the whole interface as lines fitting the size of the terminal
+func (v *view) render() []string {
+	v.scroll()
+	currency := v.budget.ReportingCurrency()
+
+	title := fmt.Sprintf(" BudgetBuddy - %s (%s)", v.name, currency)
+	if v.changed {
+		title += " [modified]"
+	}
+	lines := []string{termenv.String(text.Trim(title, v.width)).Bold().String()}
+
+	leftWidth := v.width / 2
+	rightWidth := v.width - leftWidth - 1
+	left := v.renderPane(incomePane, "Income", v.budget.Income.Sum(currency), leftWidth)
+	right := v.renderPane(expensePane, "Expenses", v.budget.Expenses.Sum(currency), rightWidth)
+	for index := range left {
+		lines = append(lines, left[index]+"│"+right[index])
+	}
+
+	lines = append(lines, v.renderSummary(v.width)...)
+
+	switch {
+	case v.leaving:
+		lines = append(lines, termenv.String("Save changes before quitting? (y)es, (n)o or (c)ancel").Foreground(termenv.ANSIYellow).String())
+	case v.editing:
+		field := v.selected()
+		prompt := fmt.Sprintf("%s: %s", strings.TrimSpace(v.rows[v.pane][v.cursor[v.pane]].label), string(v.input))
+		if v.message != "" && v.failed {
+			prompt += "  " + termenv.String(v.message).Foreground(termenv.ANSIRed).String()
+		} else if field.Currency != "" {
+			prompt += "  " + termenv.String(fmt.Sprintf("(%s)", field.Currency)).Faint().String()
+		}
+		lines = append(lines, prompt)
+	case v.failed && v.message != "":
+		lines = append(lines, termenv.String(v.message).Foreground(termenv.ANSIRed).String())
+	case v.message != "":
+		lines = append(lines, termenv.String(v.message).Foreground(termenv.ANSIGreen).String())
+	default:
+		lines = append(lines, "")
+	}
+
+	var help string
+	if v.editing {
+		help = "enter: apply  esc: cancel"
+	} else {
+		help = "↑/↓: move  tab: switch pane  enter: edit  s: save  q: quit"
+	}
+	lines = append(lines, termenv.String(text.Trim(help, v.width)).Faint().String())
+
+	return lines
+}